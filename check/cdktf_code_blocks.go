@@ -0,0 +1,143 @@
+package check
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+type CdktfCodeBlocksCheck struct {
+	Options *CdktfCodeBlocksOptions
+}
+
+// CdktfCodeBlocksOptions represents configuration options for CdktfCodeBlocks.
+type CdktfCodeBlocksOptions struct {
+	*FileOptions
+
+	Enable bool
+}
+
+func NewCdktfCodeBlocksCheck(opts *CdktfCodeBlocksOptions) *CdktfCodeBlocksCheck {
+	check := &CdktfCodeBlocksCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &CdktfCodeBlocksOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies that code blocks in CDKTF language directories use the language
+// of their directory, e.g. typescript code blocks in
+// docs/cdktf/typescript/resources files.
+//
+// Unsuccessful CDKTF conversion leaves the original terraform code blocks, so
+// these are not errors. Files with unconverted terraform or hcl code blocks are
+// instead logged as warnings with their conversion coverage.
+func (check *CdktfCodeBlocksCheck) Run(directories map[string][]string) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for _, directory := range sortedDirectories(directories) {
+		cdktfLanguage, ok := cdktfDirectoryLanguage(directory)
+
+		if !ok {
+			continue
+		}
+
+		for _, file := range directories[directory] {
+			if err := check.RunFile(file, cdktfLanguage); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies a single file of the CDKTF language directory.
+func (check *CdktfCodeBlocksCheck) RunFile(path string, cdktfLanguage string) error {
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	expectedLanguage := cdktfLanguage
+	document, _ := markdown.Parse(content)
+
+	var converted int
+	var result *multierror.Error
+	var unconvertedLines []string
+
+	err = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		fencedCodeBlock, ok := node.(*ast.FencedCodeBlock)
+
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		language := markdown.FencedCodeBlockLanguage(fencedCodeBlock, content)
+		line := markdown.FencedCodeBlockLineNumber(fencedCodeBlock, content)
+
+		switch {
+		case language == expectedLanguage:
+			converted++
+		case language == markdown.FencedCodeBlockLanguageHcl, language == markdown.FencedCodeBlockLanguageTerraform:
+			unconvertedLines = append(unconvertedLines, fmt.Sprint(line))
+		default:
+			result = multierror.Append(result, fmt.Errorf("%s: line %d: code block language (%s) should be: ```%s", path, line, language, expectedLanguage))
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: error walking file: %w", path, err)
+	}
+
+	if len(unconvertedLines) > 0 {
+		total := converted + len(unconvertedLines)
+		log.Printf("[WARN] %s: %d of %d (%.1f%%) code blocks converted to %s, unconverted code blocks at lines: %s", path, converted, total, float64(converted)*100/float64(total), expectedLanguage, strings.Join(unconvertedLines, ", "))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// cdktfDirectoryLanguage returns the CDKTF language of a CDKTF language
+// subdirectory, e.g. typescript for docs/cdktf/typescript/resources.
+func cdktfDirectoryLanguage(directory string) (string, bool) {
+	for _, indexDirectory := range []string{RegistryIndexDirectory, LegacyIndexDirectory} {
+		prefix := indexDirectory + "/" + CdktfIndexDirectory + "/"
+
+		if !strings.HasPrefix(directory, prefix) {
+			continue
+		}
+
+		cdktfLanguage := strings.SplitN(strings.TrimPrefix(directory, prefix), "/", 2)[0]
+
+		if slices.Contains(ValidCdktfLanguages, cdktfLanguage) {
+			return cdktfLanguage, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,98 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestCdktfCodeBlocksCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		BasePath     string
+		Options      *CdktfCodeBlocksOptions
+		ExpectErrors int
+	}{
+		{
+			Name:     "disabled",
+			BasePath: "testdata/cdktf-code-blocks",
+			Options:  &CdktfCodeBlocksOptions{},
+		},
+		{
+			Name:     "enabled",
+			BasePath: "testdata/cdktf-code-blocks",
+			Options: &CdktfCodeBlocksOptions{
+				Enable: true,
+			},
+			ExpectErrors: 5,
+		},
+		{
+			Name:     "enabled without cdktf directories",
+			BasePath: "testdata/valid-registry-files",
+			Options: &CdktfCodeBlocksOptions{
+				Enable: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			directories, err := GetDirectories(testCase.BasePath)
+
+			if err != nil {
+				t.Fatalf("error getting directories: %s", err)
+			}
+
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: testCase.BasePath,
+			}
+
+			got := NewCdktfCodeBlocksCheck(testCase.Options).Run(directories)
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
+
+func TestCdktfDirectoryLanguage(t *testing.T) {
+	testCases := []struct {
+		Directory      string
+		ExpectLanguage string
+		ExpectOk       bool
+	}{
+		{
+			Directory: "docs/resources",
+		},
+		{
+			Directory:      "docs/cdktf/typescript/resources",
+			ExpectLanguage: "typescript",
+			ExpectOk:       true,
+		},
+		{
+			Directory:      "website/docs/cdktf/python/d",
+			ExpectLanguage: "python",
+			ExpectOk:       true,
+		},
+		{
+			Directory: "docs/cdktf/ruby/resources",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Directory, func(t *testing.T) {
+			got, ok := cdktfDirectoryLanguage(testCase.Directory)
+
+			if got != testCase.ExpectLanguage || ok != testCase.ExpectOk {
+				t.Errorf("expected %q (%t), got %q (%t)", testCase.ExpectLanguage, testCase.ExpectOk, got, ok)
+			}
+		})
+	}
+}
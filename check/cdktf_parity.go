@@ -0,0 +1,144 @@
+package check
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+type CdktfParityCheck struct {
+	Options *CdktfParityOptions
+}
+
+// CdktfParityOptions represents configuration options for CdktfParity.
+type CdktfParityOptions struct {
+	Enable bool
+
+	// Require reports missing and extraneous CDKTF files as errors instead of
+	// warnings, which do not fail the check.
+	Require bool
+}
+
+func NewCdktfParityCheck(opts *CdktfParityOptions) *CdktfParityCheck {
+	check := &CdktfParityCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &CdktfParityOptions{}
+	}
+
+	return check
+}
+
+// Run compares the data source and resource files of each CDKTF language
+// directory with the HCL documentation files, reporting files missing from the
+// language directory or missing from the HCL documentation, and logging the
+// translation coverage of each language.
+//
+// Languages without any CDKTF directories are skipped.
+func (check *CdktfParityCheck) Run(directories map[string][]string) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for _, index := range []struct {
+		Directory      string
+		Subdirectories []string
+	}{
+		{
+			Directory:      RegistryIndexDirectory,
+			Subdirectories: []string{RegistryDataSourcesDirectory, RegistryResourcesDirectory},
+		},
+		{
+			Directory:      LegacyIndexDirectory,
+			Subdirectories: []string{LegacyDataSourcesDirectory, LegacyResourcesDirectory},
+		},
+	} {
+		for _, cdktfLanguage := range cdktfLanguages(directories, index.Directory) {
+			for _, subdirectory := range index.Subdirectories {
+				hclDirectory := index.Directory + "/" + subdirectory
+				cdktfDirectory := fmt.Sprintf("%s/%s/%s/%s", index.Directory, CdktfIndexDirectory, cdktfLanguage, subdirectory)
+
+				for _, err := range check.directoryErrors(hclDirectory, directories[hclDirectory], cdktfLanguage, cdktfDirectory, directories[cdktfDirectory]) {
+					if !check.Options.Require {
+						log.Printf("[WARN] %s", err)
+						continue
+					}
+
+					result = multierror.Append(result, err)
+				}
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// directoryErrors returns an error for each HCL file without a CDKTF file of
+// the same name and each CDKTF file without an HCL file of the same name.
+func (check *CdktfParityCheck) directoryErrors(hclDirectory string, hclFiles []string, cdktfLanguage string, cdktfDirectory string, cdktfFiles []string) []error {
+	hclNames := fileBaseNames(hclFiles)
+	cdktfNames := fileBaseNames(cdktfFiles)
+
+	var errs []error
+	var translated int
+
+	for _, file := range hclFiles {
+		if cdktfNames[filepath.Base(file)] {
+			translated++
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: missing %s CDKTF file: %s/%s", file, cdktfLanguage, cdktfDirectory, filepath.Base(file)))
+	}
+
+	for _, file := range cdktfFiles {
+		if hclNames[filepath.Base(file)] {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: matching HCL file for %s CDKTF file not found: %s/%s", file, cdktfLanguage, hclDirectory, filepath.Base(file)))
+	}
+
+	if len(hclFiles) > 0 {
+		log.Printf("[INFO] Found %s CDKTF files for %d of %d (%.1f%%) files in directory: %s", cdktfLanguage, translated, len(hclFiles), float64(translated)*100/float64(len(hclFiles)), hclDirectory)
+	}
+
+	return errs
+}
+
+// cdktfLanguages returns the CDKTF languages with directories in the
+// documentation index directory.
+func cdktfLanguages(directories map[string][]string, indexDirectory string) []string {
+	var result []string
+
+	for _, cdktfLanguage := range ValidCdktfLanguages {
+		prefix := fmt.Sprintf("%s/%s/%s", indexDirectory, CdktfIndexDirectory, cdktfLanguage)
+
+		for directory := range directories {
+			if directory == prefix || strings.HasPrefix(directory, prefix+"/") {
+				result = append(result, cdktfLanguage)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// fileBaseNames returns the set of file base names.
+func fileBaseNames(files []string) map[string]bool {
+	result := make(map[string]bool, len(files))
+
+	for _, file := range files {
+		result[filepath.Base(file)] = true
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestCdktfParityCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		BasePath     string
+		Options      *CdktfParityOptions
+		ExpectErrors int
+	}{
+		{
+			Name:     "disabled",
+			BasePath: "testdata/cdktf-parity",
+			Options:  &CdktfParityOptions{},
+		},
+		{
+			Name:     "enabled",
+			BasePath: "testdata/cdktf-parity",
+			Options: &CdktfParityOptions{
+				Enable: true,
+			},
+		},
+		{
+			Name:     "required",
+			BasePath: "testdata/cdktf-parity",
+			Options: &CdktfParityOptions{
+				Enable:  true,
+				Require: true,
+			},
+			ExpectErrors: 3,
+		},
+		{
+			Name:     "required without cdktf directories",
+			BasePath: "testdata/valid-registry-directories",
+			Options: &CdktfParityOptions{
+				Enable:  true,
+				Require: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			directories, err := GetDirectories(testCase.BasePath)
+
+			if err != nil {
+				t.Fatalf("error getting directories: %s", err)
+			}
+
+			got := NewCdktfParityCheck(testCase.Options).Run(directories)
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+type CdktfStalenessCheck struct {
+	Options *CdktfStalenessOptions
+}
+
+// CdktfStalenessOptions represents configuration options for CdktfStaleness.
+type CdktfStalenessOptions struct {
+	*FileOptions
+
+	Enable bool
+}
+
+func NewCdktfStalenessCheck(opts *CdktfStalenessOptions) *CdktfStalenessCheck {
+	check := &CdktfStalenessCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &CdktfStalenessOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies that files in CDKTF language directories match the structure of
+// their HCL source file, e.g. docs/cdktf/typescript/resources/thing.md and
+// docs/resources/thing.md, to find translations that were not regenerated.
+//
+// CDKTF conversion only changes code block contents, so the headings, argument
+// and attribute names, and number of example code blocks are compared.
+// Files without an HCL source file are skipped.
+func (check *CdktfStalenessCheck) Run(directories map[string][]string) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for _, directory := range sortedDirectories(directories) {
+		cdktfLanguage, ok := cdktfDirectoryLanguage(directory)
+
+		if !ok {
+			continue
+		}
+
+		for _, file := range directories[directory] {
+			if err := check.RunFile(file, cdktfSourcePath(file, cdktfLanguage)); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies a single CDKTF file against its HCL source file.
+func (check *CdktfStalenessCheck) RunFile(path string, sourcePath string) error {
+	sourceContent, err := check.Options.ReadFile(sourcePath)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Printf("[DEBUG] Skipping %s CDKTF staleness check, HCL file not found: %s", path, sourcePath)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading HCL file (%s): %w", path, sourcePath, err)
+	}
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	got := newCdktfFingerprint(content)
+	want := newCdktfFingerprint(sourceContent)
+
+	var result *multierror.Error
+
+	for _, difference := range []struct {
+		Description string
+		Got         []string
+		Want        []string
+	}{
+		{
+			Description: "headings",
+			Got:         got.Headings,
+			Want:        want.Headings,
+		},
+		{
+			Description: "argument and attribute names",
+			Got:         got.ListItemNames,
+			Want:        want.ListItemNames,
+		},
+	} {
+		if removed := stringsDifference(difference.Got, difference.Want); len(removed) > 0 {
+			result = multierror.Append(result, fmt.Errorf("%s: stale CDKTF file, %s not found in HCL file (%s): %s", path, difference.Description, sourcePath, strings.Join(removed, ", ")))
+		}
+
+		if added := stringsDifference(difference.Want, difference.Got); len(added) > 0 {
+			result = multierror.Append(result, fmt.Errorf("%s: stale CDKTF file, missing %s of HCL file (%s): %s", path, difference.Description, sourcePath, strings.Join(added, ", ")))
+		}
+	}
+
+	if got.ExampleCodeBlocks != want.ExampleCodeBlocks {
+		result = multierror.Append(result, fmt.Errorf("%s: stale CDKTF file, number of example code blocks (%d) should match HCL file (%s): %d", path, got.ExampleCodeBlocks, sourcePath, want.ExampleCodeBlocks))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// cdktfFingerprint represents the structure of a documentation file that is
+// unchanged by CDKTF conversion.
+type cdktfFingerprint struct {
+	// ExampleCodeBlocks is the number of code blocks below headings starting
+	// with Example, e.g. ## Example Usage
+	ExampleCodeBlocks int
+
+	// Headings contains the heading texts with Markdown level prefix, e.g. ## Import
+	Headings []string
+
+	// ListItemNames contains the code span names starting list items, e.g.
+	// name in * `name` - (Required) Name of the thing.
+	ListItemNames []string
+}
+
+// newCdktfFingerprint returns the fingerprint of the Markdown source.
+func newCdktfFingerprint(src []byte) *cdktfFingerprint {
+	document, _ := markdown.Parse(src)
+	result := &cdktfFingerprint{}
+
+	var exampleLevel int
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.Heading:
+			text := string(node.Text(src))
+			result.Headings = append(result.Headings, strings.Repeat("#", node.Level)+" "+text)
+
+			switch {
+			case exampleLevel > 0 && node.Level > exampleLevel:
+			case strings.HasPrefix(text, "Example"):
+				exampleLevel = node.Level
+			default:
+				exampleLevel = 0
+			}
+
+			return ast.WalkSkipChildren, nil
+		case *ast.FencedCodeBlock:
+			if exampleLevel > 0 {
+				result.ExampleCodeBlocks++
+			}
+
+			return ast.WalkSkipChildren, nil
+		case *ast.ListItem:
+			if codeSpan, ok := listItemCodeSpan(node); ok {
+				result.ListItemNames = append(result.ListItemNames, string(codeSpan.Text(src)))
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return result
+}
+
+// cdktfSourcePath returns the HCL source file path of a CDKTF file, e.g.
+// docs/resources/thing.md for docs/cdktf/typescript/resources/thing.md.
+func cdktfSourcePath(path string, cdktfLanguage string) string {
+	for _, indexDirectory := range []string{RegistryIndexDirectory, LegacyIndexDirectory} {
+		prefix := indexDirectory + "/" + CdktfIndexDirectory + "/" + cdktfLanguage + "/"
+
+		if strings.HasPrefix(path, prefix) {
+			return indexDirectory + "/" + strings.TrimPrefix(path, prefix)
+		}
+	}
+
+	return path
+}
+
+// listItemCodeSpan returns the code span at the start of the list item text.
+func listItemCodeSpan(listItem *ast.ListItem) (*ast.CodeSpan, bool) {
+	block := listItem.FirstChild()
+
+	if block == nil {
+		return nil, false
+	}
+
+	codeSpan, ok := block.FirstChild().(*ast.CodeSpan)
+
+	return codeSpan, ok
+}
+
+// stringsDifference returns the sorted unique strings of a not found in b.
+func stringsDifference(a []string, b []string) []string {
+	found := make(map[string]bool, len(b))
+
+	for _, s := range b {
+		found[s] = true
+	}
+
+	var result []string
+
+	for _, s := range a {
+		if found[s] {
+			continue
+		}
+
+		found[s] = true
+		result = append(result, s)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
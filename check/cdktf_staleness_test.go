@@ -0,0 +1,92 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestCdktfStalenessCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		BasePath     string
+		Options      *CdktfStalenessOptions
+		ExpectErrors int
+	}{
+		{
+			Name:     "disabled",
+			BasePath: "testdata/cdktf-staleness",
+			Options:  &CdktfStalenessOptions{},
+		},
+		{
+			Name:     "enabled",
+			BasePath: "testdata/cdktf-staleness",
+			Options: &CdktfStalenessOptions{
+				Enable: true,
+			},
+			ExpectErrors: 4,
+		},
+		{
+			Name:     "enabled without cdktf directories",
+			BasePath: "testdata/valid-registry-files",
+			Options: &CdktfStalenessOptions{
+				Enable: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			directories, err := GetDirectories(testCase.BasePath)
+
+			if err != nil {
+				t.Fatalf("error getting directories: %s", err)
+			}
+
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: testCase.BasePath,
+			}
+
+			got := NewCdktfStalenessCheck(testCase.Options).Run(directories)
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
+
+func TestCdktfSourcePath(t *testing.T) {
+	testCases := []struct {
+		Path          string
+		CdktfLanguage string
+		Expect        string
+	}{
+		{
+			Path:          "docs/cdktf/typescript/resources/thing.md",
+			CdktfLanguage: "typescript",
+			Expect:        "docs/resources/thing.md",
+		},
+		{
+			Path:          "website/docs/cdktf/python/d/thing.html.markdown",
+			CdktfLanguage: "python",
+			Expect:        "website/docs/d/thing.html.markdown",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Path, func(t *testing.T) {
+			got := cdktfSourcePath(testCase.Path, testCase.CdktfLanguage)
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
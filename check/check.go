@@ -24,16 +24,32 @@ type Check struct {
 }
 
 type CheckOptions struct {
+	CdktfCodeBlocks *CdktfCodeBlocksOptions
+
+	CdktfParity *CdktfParityOptions
+
+	CdktfStaleness *CdktfStalenessOptions
+
 	DataSourceFileMismatch *FileMismatchOptions
 
+	ExampleSources *ExampleSourcesOptions
+
+	Examples *ExamplesOptions
+
 	FunctionFileMismatch *FileMismatchOptions
 
+	GeneratedFiles *GeneratedFilesOptions
+
 	LegacyDataSourceFile *LegacyDataSourceFileOptions
 	LegacyFunctionFile   *LegacyFunctionFileOptions
 	LegacyGuideFile      *LegacyGuideFileOptions
 	LegacyIndexFile      *LegacyIndexFileOptions
 	LegacyResourceFile   *LegacyResourceFileOptions
 
+	Limits *LimitsOptions
+
+	Links *LinksOptions
+
 	ProviderName   string
 	ProviderSource string
 
@@ -45,6 +61,12 @@ type CheckOptions struct {
 
 	ResourceFileMismatch *FileMismatchOptions
 
+	Templates *TemplatesOptions
+
+	// EnableRegistryCdktf enables checking data source and resource files in
+	// Terraform Registry CDKTF language directories (e.g. docs/cdktf/typescript)
+	EnableRegistryCdktf bool
+
 	IgnoreCdktfMissingFiles bool
 }
 
@@ -69,7 +91,7 @@ func (check *Check) Run(directories map[string][]string) error {
 		return err
 	}
 
-	if err := NumberOfFilesCheck(directories); err != nil {
+	if err := NewLimitsCheck(check.Options.Limits).Run(directories); err != nil {
 		return err
 	}
 
@@ -117,29 +139,31 @@ func (check *Check) Run(directories map[string][]string) error {
 		}
 	}
 
-	for _, cdktfLanguage := range ValidCdktfLanguages {
-		if files, ok := directories[fmt.Sprintf("%s/%s/%s/%s", RegistryIndexDirectory, CdktfIndexDirectory, cdktfLanguage, RegistryDataSourcesDirectory)]; ok {
-			if !check.Options.IgnoreCdktfMissingFiles {
-				if err := NewFileMismatchCheck(check.Options.DataSourceFileMismatch).Run(files); err != nil {
+	if check.Options.EnableRegistryCdktf {
+		for _, cdktfLanguage := range ValidCdktfLanguages {
+			if files, ok := directories[fmt.Sprintf("%s/%s/%s/%s", RegistryIndexDirectory, CdktfIndexDirectory, cdktfLanguage, RegistryDataSourcesDirectory)]; ok {
+				if !check.Options.IgnoreCdktfMissingFiles {
+					if err := NewFileMismatchCheck(check.Options.DataSourceFileMismatch).Run(files); err != nil {
+						result = multierror.Append(result, err)
+					}
+				}
+
+				if err := NewRegistryDataSourceFileCheck(check.Options.RegistryDataSourceFile).RunAll(files); err != nil {
 					result = multierror.Append(result, err)
 				}
 			}
 
-			if err := NewRegistryDataSourceFileCheck(check.Options.RegistryDataSourceFile).RunAll(files); err != nil {
-				result = multierror.Append(result, err)
-			}
-		}
+			if files, ok := directories[fmt.Sprintf("%s/%s/%s/%s", RegistryIndexDirectory, CdktfIndexDirectory, cdktfLanguage, RegistryResourcesDirectory)]; ok {
+				if !check.Options.IgnoreCdktfMissingFiles {
+					if err := NewFileMismatchCheck(check.Options.ResourceFileMismatch).Run(files); err != nil {
+						result = multierror.Append(result, err)
+					}
+				}
 
-		if files, ok := directories[fmt.Sprintf("%s/%s/%s/%s", RegistryIndexDirectory, CdktfIndexDirectory, cdktfLanguage, RegistryResourcesDirectory)]; ok {
-			if !check.Options.IgnoreCdktfMissingFiles {
-				if err := NewFileMismatchCheck(check.Options.ResourceFileMismatch).Run(files); err != nil {
+				if err := NewRegistryResourceFileCheck(check.Options.RegistryResourceFile).RunAll(files, cdktfLanguage); err != nil {
 					result = multierror.Append(result, err)
 				}
 			}
-
-			if err := NewRegistryResourceFileCheck(check.Options.RegistryResourceFile).RunAll(files, cdktfLanguage); err != nil {
-				result = multierror.Append(result, err)
-			}
 		}
 	}
 
@@ -215,6 +239,38 @@ func (check *Check) Run(directories map[string][]string) error {
 		}
 	}
 
+	if err := NewCdktfCodeBlocksCheck(check.Options.CdktfCodeBlocks).Run(directories); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := NewCdktfParityCheck(check.Options.CdktfParity).Run(directories); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := NewCdktfStalenessCheck(check.Options.CdktfStaleness).Run(directories); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := NewLinksCheck(check.Options.Links).Run(directories); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := NewExampleSourcesCheck(check.Options.ExampleSources).Run(directories); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := NewExamplesCheck(check.Options.Examples).Run(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := NewGeneratedFilesCheck(check.Options.GeneratedFiles).Run(directories); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := NewTemplatesCheck(check.Options.Templates).Run(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	if result != nil {
 		sort.Sort(result)
 	}
@@ -1,13 +1,17 @@
 package check
 
 import (
+	"io/fs"
+	"os"
 	"testing"
+	"testing/fstest"
 )
 
 func TestCheck(t *testing.T) {
 	testCases := []struct {
 		Name        string
 		BasePath    string
+		FS          fs.FS
 		Options     *CheckOptions
 		ExpectError bool
 	}{
@@ -19,6 +23,38 @@ func TestCheck(t *testing.T) {
 			Name:     "valid registry directories with cdktf docs",
 			BasePath: "testdata/valid-registry-directories-with-cdktf",
 		},
+		{
+			Name:     "invalid registry cdktf docs",
+			BasePath: "testdata/invalid-registry-directories-with-cdktf",
+		},
+		{
+			Name:     "invalid registry cdktf docs with registry cdktf check",
+			BasePath: "testdata/invalid-registry-directories-with-cdktf",
+			Options: &CheckOptions{
+				EnableRegistryCdktf: true,
+			},
+			ExpectError: true,
+		},
+		{
+			Name:     "missing and extraneous cdktf docs with cdktf parity check",
+			BasePath: "testdata/cdktf-parity-missing-files",
+			Options: &CheckOptions{
+				CdktfParity: &CdktfParityOptions{
+					Enable: true,
+				},
+			},
+		},
+		{
+			Name:     "missing and extraneous cdktf docs with required cdktf parity",
+			BasePath: "testdata/cdktf-parity-missing-files",
+			Options: &CheckOptions{
+				CdktfParity: &CdktfParityOptions{
+					Enable:  true,
+					Require: true,
+				},
+			},
+			ExpectError: true,
+		},
 		{
 			Name:     "valid legacy directories",
 			BasePath: "testdata/valid-legacy-directories",
@@ -46,12 +82,40 @@ func TestCheck(t *testing.T) {
 			BasePath:    "testdata/invalid-mixed-directories",
 			ExpectError: true,
 		},
+		{
+			Name:     "valid registry directories in file system",
+			BasePath: "valid-registry-directories",
+			FS:       os.DirFS("testdata"),
+		},
+		{
+			Name:     "valid legacy directories in file system",
+			BasePath: "valid-legacy-directories",
+			FS:       os.DirFS("testdata"),
+		},
+		{
+			Name: "valid in-memory file system",
+			FS: fstest.MapFS{
+				"docs/resources/thing.md": &fstest.MapFile{
+					Data: []byte("---\nsubcategory: \"Example\"\npage_title: \"Example: test_thing\"\ndescription: |-\n  Example description.\n---\n\n# Resource: test_thing\n"),
+				},
+			},
+		},
+		{
+			Name: "invalid in-memory file system",
+			FS: fstest.MapFS{
+				"docs/resources/thing.md": &fstest.MapFile{
+					Data: []byte("---\nlayout: \"example\"\n---\n\n# Resource: test_thing\n"),
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			fileOpts := &FileOptions{
 				BasePath: testCase.BasePath,
+				FS:       testCase.FS,
 			}
 
 			if testCase.Options == nil {
@@ -178,7 +242,7 @@ func TestCheck(t *testing.T) {
 				testCase.Options.ResourceFileMismatch.ProviderName = "test"
 			}
 
-			directories, err := GetDirectories(testCase.BasePath)
+			directories, err := GetDirectoriesFS(testCase.FS, testCase.BasePath)
 
 			if err != nil {
 				t.Fatalf("error getting directories for path (%s): %s", testCase.BasePath, err)
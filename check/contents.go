@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/bflad/tfproviderdocs/check/contents"
+	"github.com/bflad/tfproviderdocs/markdown"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 type ContentsCheck struct {
@@ -14,9 +16,26 @@ type ContentsCheck struct {
 type ContentsOptions struct {
 	*FileOptions
 
-	Enable                bool
-	ProviderName          string
-	RequireSchemaOrdering bool
+	Enable bool
+
+	// Profile contains the expected sections, defaulting to contents.DefaultProfile
+	Profile *contents.Profile
+
+	ProviderName string
+
+	// ProviderSchema enables validation of example code blocks against the provider schema
+	ProviderSchema *tfjson.ProviderSchema
+
+	// RequireCalloutSyntax verifies callout paragraph syntax and that HTML
+	// callouts are not used
+	RequireCalloutSyntax bool
+
+	// RequireDeprecationWarning requires a warning callout for resources
+	// deprecated in the provider schema
+	RequireDeprecationWarning bool
+
+	RequireSchemaOrdering   bool
+	ResourceIdentitySchemas map[string]*tfjson.IdentitySchema
 }
 
 func NewContentsCheck(opts *ContentsOptions) *ContentsCheck {
@@ -35,7 +54,7 @@ func NewContentsCheck(opts *ContentsOptions) *ContentsCheck {
 	return check
 }
 
-func (check *ContentsCheck) Run(path string, exampleLanguage string) error {
+func (check *ContentsCheck) Run(path string, content []byte, exampleLanguage string) error {
 	if !check.Options.Enable {
 		return nil
 	}
@@ -49,12 +68,29 @@ func (check *ContentsCheck) Run(path string, exampleLanguage string) error {
 		},
 		ExamplesSection: &contents.CheckExamplesSectionOptions{
 			ExpectedCodeBlockLanguage: exampleLanguage,
+			ProviderSchema:            check.Options.ProviderSchema,
 		},
+		Callouts: &contents.CheckCalloutsOptions{
+			RequireSyntax: check.Options.RequireCalloutSyntax,
+		},
+		ImportSection: &contents.CheckImportSectionOptions{},
+	}
+
+	// CDKTF import sections do not contain HCL import blocks
+	if exampleLanguage == markdown.FencedCodeBlockLanguageTerraform {
+		checkOpts.ImportSection.ResourceIdentitySchemas = check.Options.ResourceIdentitySchemas
 	}
 
 	doc := contents.NewDocument(path, check.Options.ProviderName)
+	doc.Profile = check.Options.Profile
+
+	if check.Options.RequireDeprecationWarning && check.Options.ProviderSchema != nil {
+		if schema, ok := check.Options.ProviderSchema.ResourceSchemas[doc.ResourceName]; ok && schema.Block != nil && schema.Block.Deprecated {
+			checkOpts.Callouts.RequiredCalloutTypes = append(checkOpts.Callouts.RequiredCalloutTypes, contents.CalloutTypeWarning)
+		}
+	}
 
-	if err := doc.Parse(); err != nil {
+	if err := doc.ParseSource(content); err != nil {
 		return fmt.Errorf("error parsing file: %w", err)
 	}
 
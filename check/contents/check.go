@@ -3,7 +3,9 @@ package contents
 type CheckOptions struct {
 	ArgumentsSection  *CheckArgumentsSectionOptions
 	AttributesSection *CheckAttributesSectionOptions
+	Callouts          *CheckCalloutsOptions
 	ExamplesSection   *CheckExamplesSectionOptions
+	ImportSection     *CheckImportSectionOptions
 }
 
 func (d *Document) Check(opts *CheckOptions) error {
@@ -33,5 +35,13 @@ func (d *Document) Check(opts *CheckOptions) error {
 		return err
 	}
 
+	if err := d.checkCallouts(); err != nil {
+		return err
+	}
+
+	if err := d.checkSectionOrder(); err != nil {
+		return err
+	}
+
 	return nil
 }
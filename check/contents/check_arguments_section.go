@@ -2,7 +2,6 @@ package contents
 
 import (
 	"fmt"
-	"sort"
 )
 
 type CheckArgumentsSectionOptions struct {
@@ -16,30 +15,28 @@ func (d *Document) checkArgumentsSection() error {
 		checkOpts = d.CheckOptions.ArgumentsSection
 	}
 
-	section := d.Sections.Arguments
-
-	if section == nil {
-		return fmt.Errorf("missing arguments section: ## Argument Reference")
-	}
+	// Generated (tfplugindocs) documentation includes arguments in the schema section
+	if d.Sections.Arguments == nil && d.Sections.Schema != nil {
+		if d.profile().section(ProfileSectionArguments) == nil {
+			return nil
+		}
 
-	heading := section.Heading
+		if checkOpts.RequireSchemaOrdering && !(*SchemaAttributeSection)(d.Sections.Schema.Arguments).isSorted() {
+			return fmt.Errorf("schema section arguments are not sorted by name")
+		}
 
-	if heading.Level != 2 {
-		return fmt.Errorf("arguments section heading level (%d) should be: 2", heading.Level)
+		return nil
 	}
 
-	headingText := string(heading.Text(d.source))
-	expectedHeadingText := "Argument Reference"
-
-	if headingText != expectedHeadingText {
-		return fmt.Errorf("arguments section heading (%s) should be: %s", headingText, expectedHeadingText)
+	if ok, err := d.checkProfileSection(ProfileSectionArguments); !ok || err != nil {
+		return err
 	}
 
+	section := d.Sections.Arguments
+
 	if checkOpts.RequireSchemaOrdering {
-		for _, list := range section.SchemaAttributeLists {
-			if !sort.IsSorted(SchemaAttributeListItemByName(list.Items)) {
-				return fmt.Errorf("arguments section is not sorted by name")
-			}
+		if !(*SchemaAttributeSection)(section).isSorted() {
+			return fmt.Errorf("arguments section is not sorted by name")
 		}
 	}
 
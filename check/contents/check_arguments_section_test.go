@@ -51,6 +51,43 @@ func TestCheckArgumentsSection(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		{
+			Name:         "schema wrong list order without ordering",
+			Path:         "testdata/schema/wrong_argument_list_order.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "schema passing",
+			Path:         "testdata/schema/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+		},
+		{
+			Name:         "schema wrong list order",
+			Path:         "testdata/schema/wrong_argument_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema wrong nested list order",
+			Path:         "testdata/schema/wrong_nested_argument_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2,7 +2,6 @@ package contents
 
 import (
 	"fmt"
-	"sort"
 )
 
 type CheckAttributesSectionOptions struct {
@@ -16,25 +15,25 @@ func (d *Document) checkAttributesSection() error {
 		checkOpts = d.CheckOptions.AttributesSection
 	}
 
-	section := d.Sections.Attributes
-
-	if section == nil {
-		return fmt.Errorf("missing attributes section: ## Attributes Reference")
-	}
+	// Generated (tfplugindocs) documentation includes attributes in the schema section
+	if d.Sections.Attributes == nil && d.Sections.Schema != nil {
+		if d.profile().section(ProfileSectionAttributes) == nil {
+			return nil
+		}
 
-	heading := section.Heading
+		if checkOpts.RequireSchemaOrdering && !(*SchemaAttributeSection)(d.Sections.Schema.Attributes).isSorted() {
+			return fmt.Errorf("schema section attributes are not sorted by name")
+		}
 
-	if heading.Level != 2 {
-		return fmt.Errorf("attributes section heading level (%d) should be: 2", heading.Level)
+		return nil
 	}
 
-	headingText := string(heading.Text(d.source))
-	expectedHeadingText := "Attributes Reference"
-
-	if headingText != expectedHeadingText {
-		return fmt.Errorf("attributes section heading (%s) should be: %s", headingText, expectedHeadingText)
+	if ok, err := d.checkProfileSection(ProfileSectionAttributes); !ok || err != nil {
+		return err
 	}
 
+	section := d.Sections.Attributes
+
 	paragraphs := section.Paragraphs
 	expectedBylineTexts := []string{
 		"In addition to all arguments above, the following attributes are exported:",
@@ -53,10 +52,8 @@ func (d *Document) checkAttributesSection() error {
 	}
 
 	if checkOpts.RequireSchemaOrdering {
-		for _, list := range section.SchemaAttributeLists {
-			if !sort.IsSorted(SchemaAttributeListItemByName(list.Items)) {
-				return fmt.Errorf("attributes section is not sorted by name")
-			}
+		if !(*SchemaAttributeSection)(section).isSorted() {
+			return fmt.Errorf("attributes section is not sorted by name")
 		}
 	}
 
@@ -68,6 +68,43 @@ func TestCheckAttributesSection(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		{
+			Name:         "schema wrong list order without ordering",
+			Path:         "testdata/schema/wrong_attribute_list_order.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "schema passing",
+			Path:         "testdata/schema/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+		},
+		{
+			Name:         "schema wrong list order",
+			Path:         "testdata/schema/wrong_attribute_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema wrong nested list order",
+			Path:         "testdata/schema/wrong_nested_attribute_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,150 @@
+package contents
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+const (
+	CalloutTypeDanger  = "danger"
+	CalloutTypeNote    = "note"
+	CalloutTypeWarning = "warning"
+)
+
+// calloutPrefixes maps Terraform Registry paragraph prefixes to callout types
+var calloutPrefixes = map[string]string{
+	"!>": CalloutTypeDanger,
+	"->": CalloutTypeNote,
+	"~>": CalloutTypeWarning,
+}
+
+// calloutLabelRegexp matches the expected bold callout label, e.g. **Note:**
+var calloutLabelRegexp = regexp.MustCompile(`^\*\*[A-Za-z][A-Za-z ]*:\*\*\s`)
+
+// calloutHtmlRegexp matches HTML callouts, e.g. <div class="note">
+var calloutHtmlRegexp = regexp.MustCompile(`(?i)^<div\s[^>]*class\s*=\s*"[^"]*\b(alert|danger|note|warning)\b`)
+
+type CheckCalloutsOptions struct {
+	// RequireSyntax verifies callout paragraph syntax and that HTML callouts
+	// are not used.
+	RequireSyntax bool
+
+	// RequiredCalloutTypes contains callout types that must be present, e.g.
+	// a warning callout on resources deprecated in the provider schema.
+	RequiredCalloutTypes []string
+}
+
+// checkCallouts verifies note (->), warning (~>), and danger (!>) callout
+// paragraphs across the whole document use the expected syntax, e.g.
+//
+//	~> **Note:** Example callout.
+func (d *Document) checkCallouts() error {
+	checkOpts := &CheckCalloutsOptions{}
+
+	if d.CheckOptions != nil && d.CheckOptions.Callouts != nil {
+		checkOpts = d.CheckOptions.Callouts
+	}
+
+	if !checkOpts.RequireSyntax && len(checkOpts.RequiredCalloutTypes) == 0 {
+		return nil
+	}
+
+	calloutTypes := make(map[string]bool)
+
+	var result *multierror.Error
+
+	err := ast.Walk(d.document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.HTMLBlock:
+			if checkOpts.RequireSyntax && calloutHtmlRegexp.MatchString(markdown.NodeRawText(node, d.source)) {
+				result = multierror.Append(result, fmt.Errorf("HTML callout (line %d) should use Markdown callout syntax, e.g.: -> **Note:**", markdown.NodeLineNumber(node, d.source)))
+			}
+
+			return ast.WalkSkipChildren, nil
+		case *ast.Paragraph, *ast.TextBlock:
+			// Tight list items contain text blocks instead of paragraphs
+			calloutType, err := checkCallout(node, d.source, checkOpts)
+
+			if calloutType != "" {
+				calloutTypes[calloutType] = true
+			}
+
+			if err != nil {
+				result = multierror.Append(result, err)
+			}
+
+			return ast.WalkSkipChildren, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	requiredCalloutTypes := make([]string, len(checkOpts.RequiredCalloutTypes))
+	copy(requiredCalloutTypes, checkOpts.RequiredCalloutTypes)
+	sort.Strings(requiredCalloutTypes)
+
+	for _, requiredCalloutType := range requiredCalloutTypes {
+		if calloutTypes[requiredCalloutType] {
+			continue
+		}
+
+		result = multierror.Append(result, missingCalloutError(requiredCalloutType))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkCallout returns the callout type of a paragraph or text block, if any,
+// and an error if the callout syntax is not as expected.
+func checkCallout(node ast.Node, source []byte, checkOpts *CheckCalloutsOptions) (string, error) {
+	text := markdown.NodeRawText(node, source)
+
+	if len(text) < 2 {
+		return "", nil
+	}
+
+	prefix := text[:2]
+	calloutType, ok := calloutPrefixes[prefix]
+
+	if !ok || !checkOpts.RequireSyntax {
+		return calloutType, nil
+	}
+
+	line := markdown.NodeLineNumber(node, source)
+	label := text[2:]
+
+	if !strings.HasPrefix(label, " ") {
+		return calloutType, fmt.Errorf("%s callout (line %d) should have a space after: %s", calloutType, line, prefix)
+	}
+
+	if !calloutLabelRegexp.MatchString(strings.TrimLeft(label, " ")) {
+		return calloutType, fmt.Errorf("%s callout (line %d) should start with a bold label, e.g.: %s **Note:**", calloutType, line, prefix)
+	}
+
+	return calloutType, nil
+}
+
+// missingCalloutError returns the error for a missing required callout type.
+func missingCalloutError(calloutType string) error {
+	for prefix, prefixCalloutType := range calloutPrefixes {
+		if prefixCalloutType == calloutType {
+			return fmt.Errorf("missing %s callout paragraph starting with: %s", calloutType, prefix)
+		}
+	}
+
+	return fmt.Errorf("missing %s callout", calloutType)
+}
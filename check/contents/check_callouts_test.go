@@ -0,0 +1,163 @@
+package contents
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+func TestCheckCallouts(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		ProviderName string
+		Options      *CheckOptions
+		ExpectError  bool
+		ExpectErrors int
+	}{
+		{
+			Name:         "passing",
+			Path:         "testdata/callouts/passing.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequireSyntax: true,
+				},
+			},
+		},
+		{
+			Name:         "passing note",
+			Path:         "testdata/callouts/passing_note.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequireSyntax: true,
+				},
+			},
+		},
+		{
+			Name:         "missing space",
+			Path:         "testdata/callouts/missing_space.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequireSyntax: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing bold label",
+			Path:         "testdata/callouts/missing_bold_label.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequireSyntax: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "wrong bold label",
+			Path:         "testdata/callouts/wrong_bold_label.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequireSyntax: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "html",
+			Path:         "testdata/callouts/html.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequireSyntax: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing space in unknown section",
+			Path:         "testdata/callouts/unknown_section.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequireSyntax: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "multiple",
+			Path:         "testdata/callouts/multiple.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequireSyntax: true,
+				},
+			},
+			ExpectError:  true,
+			ExpectErrors: 3,
+		},
+		{
+			Name:         "missing space syntax not required",
+			Path:         "testdata/callouts/missing_space.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "required warning",
+			Path:         "testdata/callouts/passing.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequiredCalloutTypes: []string{CalloutTypeWarning},
+				},
+			},
+		},
+		{
+			Name:         "required warning missing",
+			Path:         "testdata/callouts/passing_note.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequiredCalloutTypes: []string{CalloutTypeWarning},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, testCase.ProviderName)
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = testCase.Options
+
+			got := doc.checkCallouts()
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if testCase.ExpectErrors > 0 {
+				var errs *multierror.Error
+
+				if !errors.As(got, &errs) || len(errs.Errors) != testCase.ExpectErrors {
+					t.Errorf("expected %d errors, got: %s", testCase.ExpectErrors, got)
+				}
+			}
+		})
+	}
+}
@@ -5,10 +5,15 @@ import (
 	"strings"
 
 	"github.com/bflad/tfproviderdocs/markdown"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 type CheckExamplesSectionOptions struct {
 	ExpectedCodeBlockLanguage string
+
+	// ProviderSchema enables validation of provider resource and data source
+	// blocks in code blocks against the schema
+	ProviderSchema *tfjson.ProviderSchema
 }
 
 func (d *Document) checkExampleSection() error {
@@ -20,24 +25,11 @@ func (d *Document) checkExampleSection() error {
 		checkOpts = d.CheckOptions.ExamplesSection
 	}
 
-	section := d.Sections.Example
-
-	if section == nil {
-		return fmt.Errorf("missing example section: ## Example Usage")
+	if ok, err := d.checkProfileSection(ProfileSectionExample); !ok || err != nil {
+		return err
 	}
 
-	heading := section.Heading
-
-	if heading.Level != 2 {
-		return fmt.Errorf("example section heading level (%d) should be: 2", heading.Level)
-	}
-
-	headingText := string(heading.Text(d.source))
-	expectedHeadingText := "Example Usage"
-
-	if headingText != expectedHeadingText {
-		return fmt.Errorf("example section heading (%s) should be: %s", headingText, expectedHeadingText)
-	}
+	section := d.Sections.Example
 
 	// CDKTF conversion will leave the original terraform code blocks if unsuccessful
 	if checkOpts.ExpectedCodeBlockLanguage != markdown.FencedCodeBlockLanguageTerraform {
@@ -56,6 +48,18 @@ func (d *Document) checkExampleSection() error {
 		if !strings.Contains(text, d.ResourceName) {
 			return fmt.Errorf("example section code block text should contain resource name: %s", d.ResourceName)
 		}
+
+		schemaErrs := hclSchemaErrors([]byte(markdown.FencedCodeBlockRawText(fencedCodeBlock, d.source)), d.ProviderName, checkOpts.ProviderSchema)
+
+		if len(schemaErrs) > 0 {
+			line := markdown.FencedCodeBlockLineNumber(fencedCodeBlock, d.source)
+
+			if schemaErrs[0].Range != nil {
+				line += schemaErrs[0].Range.Start.Line - 1
+			}
+
+			return fmt.Errorf("example section code block (line %d): %s", line, schemaErrs[0].Message)
+		}
 	}
 
 	return nil
@@ -2,6 +2,8 @@ package contents
 
 import (
 	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 func TestCheckExampleSection(t *testing.T) {
@@ -9,6 +11,7 @@ func TestCheckExampleSection(t *testing.T) {
 		Name         string
 		Path         string
 		ProviderName string
+		Options      *CheckOptions
 		ExpectError  bool
 	}{
 		{
@@ -46,6 +49,113 @@ func TestCheckExampleSection(t *testing.T) {
 			ProviderName: "test",
 			ExpectError:  true,
 		},
+		{
+			Name:         "schema passing",
+			Path:         "testdata/example/schema_passing.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_passing"),
+				},
+			},
+		},
+		{
+			Name:         "schema missing required argument",
+			Path:         "testdata/example/schema_missing_required_argument.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_missing_required_argument"),
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema missing required block",
+			Path:         "testdata/example/schema_missing_required_block.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_missing_required_block"),
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema read-only argument",
+			Path:         "testdata/example/schema_read_only_argument.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_read_only_argument"),
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema unknown argument",
+			Path:         "testdata/example/schema_unknown_argument.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_unknown_argument"),
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema unknown block",
+			Path:         "testdata/example/schema_unknown_block.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_unknown_block"),
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema unknown data source reference",
+			Path:         "testdata/example/schema_unknown_data_source_reference.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_unknown_data_source_reference"),
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema unknown nested argument",
+			Path:         "testdata/example/schema_unknown_nested_argument.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_unknown_nested_argument"),
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema unknown reference",
+			Path:         "testdata/example/schema_unknown_reference.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: "terraform",
+					ProviderSchema:            testExampleProviderSchema("test_schema_unknown_reference"),
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -56,6 +166,8 @@ func TestCheckExampleSection(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err)
 			}
 
+			doc.CheckOptions = testCase.Options
+
 			got := doc.checkExampleSection()
 
 			if got == nil && testCase.ExpectError {
@@ -68,3 +180,66 @@ func TestCheckExampleSection(t *testing.T) {
 		})
 	}
 }
+
+func testExampleProviderSchema(resourceName string) *tfjson.ProviderSchema {
+	return &tfjson.ProviderSchema{
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"test_thing": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"id": {
+							Computed: true,
+						},
+						"name": {
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		ResourceSchemas: map[string]*tfjson.Schema{
+			resourceName: {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn": {
+							Computed: true,
+						},
+						"description": {
+							Optional: true,
+						},
+						"id": {
+							Computed: true,
+						},
+						"name": {
+							Required: true,
+						},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"setting": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"key": {
+										Required: true,
+									},
+									"value": {
+										Optional: true,
+									},
+								},
+							},
+							MinItems: 1,
+						},
+						"tag": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"key": {
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,38 +2,171 @@ package contents
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
 )
 
+type CheckImportSectionOptions struct {
+	// ResourceIdentitySchemas contains resource identity schemas by resource name
+	//
+	// Resources with an identity schema require an import block example with an
+	// identity argument in the import section.
+	ResourceIdentitySchemas map[string]*tfjson.IdentitySchema
+}
+
 func (d *Document) checkImportSection() error {
+	checkOpts := &CheckImportSectionOptions{}
+
+	if d.CheckOptions != nil && d.CheckOptions.ImportSection != nil {
+		checkOpts = d.CheckOptions.ImportSection
+	}
+
+	identitySchema := checkOpts.ResourceIdentitySchemas[d.ResourceName]
 	section := d.Sections.Import
 
 	if section == nil {
+		if identitySchema != nil {
+			return fmt.Errorf("missing import section with identity import block example: ## Import")
+		}
+
 		return nil
 	}
 
-	heading := section.Heading
-
-	if heading.Level != 2 {
-		return fmt.Errorf("import section heading level (%d) should be: 2", heading.Level)
+	if ok, err := d.checkProfileSection(ProfileSectionImport); !ok || err != nil {
+		return err
 	}
 
-	headingText := string(heading.Text(d.source))
-	expectedHeadingText := "Import"
+	for _, fencedCodeBlock := range section.FencedCodeBlocks {
+		text := markdown.FencedCodeBlockText(fencedCodeBlock, d.source)
+
+		if !strings.Contains(text, d.ResourceName) {
+			return fmt.Errorf("import section code block text should contain resource name: %s", d.ResourceName)
+		}
+	}
 
-	if headingText != expectedHeadingText {
-		return fmt.Errorf("import section heading (%s) should be: %s", headingText, expectedHeadingText)
+	if identitySchema == nil {
+		return nil
 	}
 
+	var identityImportBlockFound bool
+
 	for _, fencedCodeBlock := range section.FencedCodeBlocks {
+		language := markdown.FencedCodeBlockLanguage(fencedCodeBlock, d.source)
+
+		if language != markdown.FencedCodeBlockLanguageTerraform && language != markdown.FencedCodeBlockLanguageHcl {
+			continue
+		}
+
 		text := markdown.FencedCodeBlockText(fencedCodeBlock, d.source)
+		importBlocksIdentityAttributeNames, err := importBlockIdentityAttributeNames(text)
 
-		if !strings.Contains(text, d.ResourceName) {
-			return fmt.Errorf("import section code block text should contain resource name: %s", d.ResourceName)
+		if err != nil {
+			return fmt.Errorf("import section code block: %w", err)
+		}
+
+		for _, names := range importBlocksIdentityAttributeNames {
+			identityImportBlockFound = true
+
+			if err := checkIdentityAttributeNames(names, identitySchema); err != nil {
+				return fmt.Errorf("import section identity import block: %w", err)
+			}
+		}
+	}
+
+	if !identityImportBlockFound {
+		return fmt.Errorf("import section should contain import block example with identity argument, e.g. import { to = %s.example, identity = { ... } }", d.ResourceName)
+	}
+
+	return nil
+}
+
+// checkIdentityAttributeNames verifies identity attribute names against a resource identity schema.
+func checkIdentityAttributeNames(names []string, identitySchema *tfjson.IdentitySchema) error {
+	found := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		if _, ok := identitySchema.Attributes[name]; !ok {
+			return fmt.Errorf("identity attribute (%s) not found in resource identity schema, expected one of: %v", name, identityAttributeNames(identitySchema))
+		}
+
+		found[name] = true
+	}
+
+	for _, name := range identityAttributeNames(identitySchema) {
+		if identitySchema.Attributes[name].RequiredForImport && !found[name] {
+			return fmt.Errorf("missing required identity attribute: %s", name)
 		}
 	}
 
 	return nil
 }
+
+// identityAttributeNames returns the sorted attribute names of a resource identity schema.
+func identityAttributeNames(identitySchema *tfjson.IdentitySchema) []string {
+	names := make([]string, 0, len(identitySchema.Attributes))
+
+	for name := range identitySchema.Attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// importBlockIdentityAttributeNames returns the identity argument object keys of each import block with an identity argument.
+func importBlockIdentityAttributeNames(text string) ([][]string, error) {
+	file, diags := hclsyntax.ParseConfig([]byte(text), "", hcl.InitialPos)
+
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("error parsing HCL: %s", diags.Error())
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+
+	if !ok {
+		return nil, nil
+	}
+
+	var result [][]string
+
+	for _, block := range body.Blocks {
+		if block.Type != "import" {
+			continue
+		}
+
+		attribute, ok := block.Body.Attributes["identity"]
+
+		if !ok {
+			continue
+		}
+
+		objectExpr, ok := attribute.Expr.(*hclsyntax.ObjectConsExpr)
+
+		if !ok {
+			return nil, fmt.Errorf("import block identity argument should be an object")
+		}
+
+		names := make([]string, 0, len(objectExpr.Items))
+
+		for _, item := range objectExpr.Items {
+			key, diags := item.KeyExpr.Value(nil)
+
+			if diags.HasErrors() || !key.Type().Equals(cty.String) || !key.IsKnown() || key.IsNull() {
+				return nil, fmt.Errorf("import block identity argument keys should be attribute names")
+			}
+
+			names = append(names, key.AsString())
+		}
+
+		result = append(result, names)
+	}
+
+	return result, nil
+}
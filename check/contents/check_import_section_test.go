@@ -2,6 +2,8 @@ package contents
 
 import (
 	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 func TestCheckImportSection(t *testing.T) {
@@ -9,6 +11,7 @@ func TestCheckImportSection(t *testing.T) {
 		Name         string
 		Path         string
 		ProviderName string
+		Options      *CheckOptions
 		ExpectError  bool
 	}{
 		{
@@ -34,6 +37,115 @@ func TestCheckImportSection(t *testing.T) {
 			ProviderName: "test",
 			ExpectError:  true,
 		},
+		{
+			Name:         "identity passing",
+			Path:         "testdata/import/identity_passing.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ImportSection: &CheckImportSectionOptions{
+					ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+						"test_identity_passing": {
+							Attributes: map[string]*tfjson.IdentityAttribute{
+								"name": {
+									RequiredForImport: true,
+								},
+								"region": {
+									OptionalForImport: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "identity missing import block",
+			Path:         "testdata/import/identity_missing_import_block.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ImportSection: &CheckImportSectionOptions{
+					ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+						"test_identity_missing_import_block": {
+							Attributes: map[string]*tfjson.IdentityAttribute{
+								"name": {
+									RequiredForImport: true,
+								},
+								"region": {
+									OptionalForImport: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "identity missing required attribute",
+			Path:         "testdata/import/identity_missing_required_attribute.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ImportSection: &CheckImportSectionOptions{
+					ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+						"test_identity_missing_required_attribute": {
+							Attributes: map[string]*tfjson.IdentityAttribute{
+								"name": {
+									RequiredForImport: true,
+								},
+								"region": {
+									OptionalForImport: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "identity unknown attribute",
+			Path:         "testdata/import/identity_unknown_attribute.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ImportSection: &CheckImportSectionOptions{
+					ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+						"test_identity_unknown_attribute": {
+							Attributes: map[string]*tfjson.IdentityAttribute{
+								"name": {
+									RequiredForImport: true,
+								},
+								"region": {
+									OptionalForImport: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "identity missing section",
+			Path:         "testdata/empty.md",
+			ProviderName: "test",
+			Options: &CheckOptions{
+				ImportSection: &CheckImportSectionOptions{
+					ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+						"test_empty": {
+							Attributes: map[string]*tfjson.IdentityAttribute{
+								"name": {
+									RequiredForImport: true,
+								},
+								"region": {
+									OptionalForImport: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -44,6 +156,8 @@ func TestCheckImportSection(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err)
 			}
 
+			doc.CheckOptions = testCase.Options
+
 			got := doc.checkImportSection()
 
 			if got == nil && testCase.ExpectError {
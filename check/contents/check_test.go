@@ -16,6 +16,11 @@ func TestCheck(t *testing.T) {
 			Path:         "testdata/full.md",
 			ProviderName: "test",
 		},
+		{
+			Name:         "passing generated",
+			Path:         "testdata/full_generated.md",
+			ProviderName: "test",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1,10 +1,8 @@
 package contents
 
 func (d *Document) checkTimeoutsSection() error {
-	section := d.Sections.Timeouts
-
-	if section == nil {
-		return nil
+	if _, err := d.checkProfileSection(ProfileSectionTimeouts); err != nil {
+		return err
 	}
 
 	return nil
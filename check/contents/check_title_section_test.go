@@ -6,10 +6,11 @@ import (
 
 func TestCheckTitleSection(t *testing.T) {
 	testCases := []struct {
-		Name         string
-		Path         string
-		ProviderName string
-		ExpectError  bool
+		Name               string
+		Path               string
+		ProviderName       string
+		ExpectError        bool
+		ExpectErrorMessage string
 	}{
 		{
 			Name:         "passing",
@@ -28,6 +29,13 @@ func TestCheckTitleSection(t *testing.T) {
 			ProviderName: "test",
 			ExpectError:  true,
 		},
+		{
+			Name:               "missing heading prefix",
+			Path:               "testdata/title/missing_heading_prefix.md",
+			ProviderName:       "test",
+			ExpectError:        true,
+			ExpectErrorMessage: "title section heading (test_missing_heading_prefix) should have prefix: \"Data Source: \" or \"Resource: \"",
+		},
 		{
 			Name:         "wrong heading level",
 			Path:         "testdata/title/wrong_heading_level.md",
@@ -41,10 +49,11 @@ func TestCheckTitleSection(t *testing.T) {
 			ExpectError:  true,
 		},
 		{
-			Name:         "wrong code block section",
-			Path:         "testdata/title/wrong_code_block_section.md",
-			ProviderName: "test",
-			ExpectError:  true,
+			Name:               "wrong code block section",
+			Path:               "testdata/title/wrong_code_block_section.md",
+			ProviderName:       "test",
+			ExpectError:        true,
+			ExpectErrorMessage: "title section code examples should be in Example Usage section",
 		},
 	}
 
@@ -65,6 +74,10 @@ func TestCheckTitleSection(t *testing.T) {
 			if got != nil && !testCase.ExpectError {
 				t.Errorf("expected no error, got error: %s", got)
 			}
+
+			if got != nil && testCase.ExpectErrorMessage != "" && got.Error() != testCase.ExpectErrorMessage {
+				t.Errorf("expected error %q, got error: %s", testCase.ExpectErrorMessage, got)
+			}
 		})
 	}
 }
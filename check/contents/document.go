@@ -12,6 +12,10 @@ import (
 
 type Document struct {
 	CheckOptions *CheckOptions
+
+	// Profile contains the expected sections, defaulting to DefaultProfile
+	Profile *Profile
+
 	ProviderName string
 	ResourceName string
 	Sections     *Sections
@@ -31,14 +35,21 @@ func NewDocument(path string, providerName string) *Document {
 }
 
 func (d *Document) Parse() error {
-	var err error
-
-	d.source, err = os.ReadFile(d.path)
+	source, err := os.ReadFile(d.path)
 
 	if err != nil {
 		return fmt.Errorf("error reading file (%s): %w", d.path, err)
 	}
 
+	return d.ParseSource(source)
+}
+
+// ParseSource parses the Markdown source of the document file, e.g. when read
+// from a file system other than the operating system file system.
+func (d *Document) ParseSource(source []byte) error {
+	var err error
+
+	d.source = source
 	d.document, d.metadata = markdown.Parse(d.source)
 
 	// d.document.Dump(d.source, 1)
@@ -46,7 +57,7 @@ func (d *Document) Parse() error {
 	// fmt.Println(d.metadata["page_title"])
 	// fmt.Println(d.metadata["description"])
 
-	d.Sections, err = sectionsWalker(d.document, d.source, d.ResourceName)
+	d.Sections, err = sectionsWalker(d.document, d.source, d.ResourceName, d.profile())
 
 	if err != nil {
 		return fmt.Errorf("error parsing file (%s) sections: %w", d.path, err)
@@ -55,6 +66,15 @@ func (d *Document) Parse() error {
 	return nil
 }
 
+// profile returns the document profile or the default profile.
+func (d *Document) profile() *Profile {
+	if d.Profile == nil {
+		return DefaultProfile()
+	}
+
+	return d.Profile
+}
+
 func resourceName(providerName string, fileName string) string {
 	return providerName + "_" + fileName[:strings.IndexByte(fileName, '.')]
 }
@@ -0,0 +1,340 @@
+package contents
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// metaArguments are Terraform language arguments available in all resource and data source blocks
+var metaArguments = []string{
+	"count",
+	"depends_on",
+	"for_each",
+	"provider",
+}
+
+// metaBlocks are Terraform language blocks available in resource and data source blocks
+var metaBlocks = []string{
+	"connection",
+	"lifecycle",
+	"provisioner",
+}
+
+// hclSchemaError represents a configuration error against the provider schema
+type hclSchemaError struct {
+	Message string
+
+	// Range is the location of the error within the HCL source, if known
+	Range *hcl.Range
+}
+
+// hclSchemaErrors returns configuration errors against the provider schema for
+// resource and data source blocks of the provider in the HCL source.
+//
+// Resource and data source types not found in the provider schema are skipped.
+// HCL syntax errors are not returned.
+func hclSchemaErrors(src []byte, providerName string, providerSchema *tfjson.ProviderSchema) []hclSchemaError {
+	if providerSchema == nil {
+		return nil
+	}
+
+	file, diags := hclsyntax.ParseConfig(src, "", hcl.InitialPos)
+
+	if diags.HasErrors() {
+		return nil
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+
+	if !ok {
+		return nil
+	}
+
+	var result []hclSchemaError
+
+	for _, block := range body.Blocks {
+		if len(block.Labels) != 2 || !strings.HasPrefix(block.Labels[0], providerName+"_") {
+			continue
+		}
+
+		var schema *tfjson.Schema
+		var blockDescription string
+
+		switch block.Type {
+		case "data":
+			schema = providerSchema.DataSourceSchemas[block.Labels[0]]
+			blockDescription = fmt.Sprintf("data source (%s)", block.Labels[0])
+		case "resource":
+			schema = providerSchema.ResourceSchemas[block.Labels[0]]
+			blockDescription = fmt.Sprintf("resource (%s)", block.Labels[0])
+		default:
+			continue
+		}
+
+		if schema == nil || schema.Block == nil {
+			continue
+		}
+
+		result = append(result, hclBodySchemaErrors(block.Body, schema.Block, blockDescription, true)...)
+	}
+
+	for _, traversal := range hclBodyTraversals(body) {
+		if err := hclTraversalSchemaError(traversal, providerName, providerSchema); err != nil {
+			result = append(result, *err)
+		}
+	}
+
+	return result
+}
+
+// hclBodySchemaErrors returns unknown, read-only, and missing required arguments
+// and blocks of the body against the schema block.
+func hclBodySchemaErrors(body *hclsyntax.Body, schemaBlock *tfjson.SchemaBlock, blockDescription string, root bool) []hclSchemaError {
+	var result []hclSchemaError
+
+	for _, name := range hclBodyAttributeNames(body) {
+		attribute := body.Attributes[name]
+		schemaAttribute, ok := schemaBlock.Attributes[name]
+
+		if !ok {
+			if root && isMetaArgument(name) {
+				continue
+			}
+
+			result = append(result, hclSchemaError{
+				Message: fmt.Sprintf("%s argument (%s) not found in provider schema", blockDescription, name),
+				Range:   attribute.SrcRange.Ptr(),
+			})
+
+			continue
+		}
+
+		if schemaAttribute.Computed && !schemaAttribute.Optional && !schemaAttribute.Required {
+			result = append(result, hclSchemaError{
+				Message: fmt.Sprintf("%s argument (%s) is read-only in provider schema", blockDescription, name),
+				Range:   attribute.SrcRange.Ptr(),
+			})
+		}
+	}
+
+	var hasDynamicBlocks bool
+	blockTypes := make(map[string]bool)
+
+	for _, block := range body.Blocks {
+		blockType := block.Type
+		blockBody := block.Body
+
+		if block.Type == "dynamic" {
+			hasDynamicBlocks = true
+
+			if len(block.Labels) != 1 {
+				continue
+			}
+
+			blockType = block.Labels[0]
+			blockBody = nil
+
+			for _, dynamicBlock := range block.Body.Blocks {
+				if dynamicBlock.Type == "content" {
+					blockBody = dynamicBlock.Body
+				}
+			}
+		}
+
+		blockTypes[blockType] = true
+		schemaBlockType, ok := schemaBlock.NestedBlocks[blockType]
+
+		if !ok {
+			if root && isMetaBlock(blockType) {
+				continue
+			}
+
+			result = append(result, hclSchemaError{
+				Message: fmt.Sprintf("%s block (%s) not found in provider schema", blockDescription, blockType),
+				Range:   block.TypeRange.Ptr(),
+			})
+
+			continue
+		}
+
+		if blockBody == nil || schemaBlockType.Block == nil {
+			continue
+		}
+
+		result = append(result, hclBodySchemaErrors(blockBody, schemaBlockType.Block, fmt.Sprintf("%s block (%s)", blockDescription, blockType), false)...)
+	}
+
+	for _, name := range schemaAttributeNames(schemaBlock) {
+		if !schemaBlock.Attributes[name].Required {
+			continue
+		}
+
+		if _, ok := body.Attributes[name]; ok {
+			continue
+		}
+
+		result = append(result, hclSchemaError{
+			Message: fmt.Sprintf("%s missing required argument (%s)", blockDescription, name),
+			Range:   body.SrcRange.Ptr(),
+		})
+	}
+
+	// Dynamic blocks may generate any block type
+	if hasDynamicBlocks {
+		return result
+	}
+
+	for _, blockType := range schemaNestedBlockTypes(schemaBlock) {
+		if schemaBlock.NestedBlocks[blockType].MinItems == 0 || blockTypes[blockType] {
+			continue
+		}
+
+		result = append(result, hclSchemaError{
+			Message: fmt.Sprintf("%s missing required block (%s)", blockDescription, blockType),
+			Range:   body.SrcRange.Ptr(),
+		})
+	}
+
+	return result
+}
+
+// hclTraversalSchemaError returns an error if the traversal references an
+// unknown attribute of a resource or data source of the provider.
+func hclTraversalSchemaError(traversal hcl.Traversal, providerName string, providerSchema *tfjson.ProviderSchema) *hclSchemaError {
+	names := hclTraversalAttributeNames(traversal)
+
+	if len(names) < 3 {
+		return nil
+	}
+
+	var schema *tfjson.Schema
+	var blockDescription, attributeName string
+
+	switch {
+	case names[0] == "data" && len(names) >= 4:
+		schema = providerSchema.DataSourceSchemas[names[1]]
+		blockDescription = fmt.Sprintf("data source (%s)", names[1])
+		attributeName = names[3]
+	case strings.HasPrefix(names[0], providerName+"_"):
+		schema = providerSchema.ResourceSchemas[names[0]]
+		blockDescription = fmt.Sprintf("resource (%s)", names[0])
+		attributeName = names[2]
+	}
+
+	if schema == nil || schema.Block == nil {
+		return nil
+	}
+
+	if _, ok := schema.Block.Attributes[attributeName]; ok {
+		return nil
+	}
+
+	if _, ok := schema.Block.NestedBlocks[attributeName]; ok {
+		return nil
+	}
+
+	return &hclSchemaError{
+		Message: fmt.Sprintf("reference to %s attribute (%s) not found in provider schema", blockDescription, attributeName),
+		Range:   traversal.SourceRange().Ptr(),
+	}
+}
+
+// hclBodyTraversals returns all variable traversals in expressions of the body and nested blocks.
+func hclBodyTraversals(body *hclsyntax.Body) []hcl.Traversal {
+	var result []hcl.Traversal
+
+	for _, name := range hclBodyAttributeNames(body) {
+		result = append(result, body.Attributes[name].Expr.Variables()...)
+	}
+
+	for _, block := range body.Blocks {
+		result = append(result, hclBodyTraversals(block.Body)...)
+	}
+
+	return result
+}
+
+// hclTraversalAttributeNames returns the leading root and attribute names of
+// the traversal, ignoring any index steps.
+func hclTraversalAttributeNames(traversal hcl.Traversal) []string {
+	var result []string
+
+	for _, traverser := range traversal {
+		switch traverser := traverser.(type) {
+		case hcl.TraverseRoot:
+			result = append(result, traverser.Name)
+		case hcl.TraverseAttr:
+			result = append(result, traverser.Name)
+		case hcl.TraverseIndex, hcl.TraverseSplat:
+			continue
+		default:
+			return result
+		}
+	}
+
+	return result
+}
+
+// hclBodyAttributeNames returns the sorted attribute names of the body.
+func hclBodyAttributeNames(body *hclsyntax.Body) []string {
+	names := make([]string, 0, len(body.Attributes))
+
+	for name := range body.Attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// schemaAttributeNames returns the sorted attribute names of the schema block.
+func schemaAttributeNames(schemaBlock *tfjson.SchemaBlock) []string {
+	names := make([]string, 0, len(schemaBlock.Attributes))
+
+	for name := range schemaBlock.Attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// schemaNestedBlockTypes returns the sorted nested block types of the schema block.
+func schemaNestedBlockTypes(schemaBlock *tfjson.SchemaBlock) []string {
+	blockTypes := make([]string, 0, len(schemaBlock.NestedBlocks))
+
+	for blockType := range schemaBlock.NestedBlocks {
+		blockTypes = append(blockTypes, blockType)
+	}
+
+	sort.Strings(blockTypes)
+
+	return blockTypes
+}
+
+func isMetaArgument(name string) bool {
+	for _, metaArgument := range metaArguments {
+		if name == metaArgument {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMetaBlock(blockType string) bool {
+	for _, metaBlock := range metaBlocks {
+		if blockType == metaBlock {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,310 @@
+package contents
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+const (
+	ContentTypeCodeBlock = "code_block"
+	ContentTypeList      = "list"
+	ContentTypeParagraph = "paragraph"
+)
+
+const (
+	ProfileSectionArguments  = "arguments"
+	ProfileSectionAttributes = "attributes"
+	ProfileSectionExample    = "example"
+	ProfileSectionImport     = "import"
+	ProfileSectionTimeouts   = "timeouts"
+	ProfileSectionTitle      = "title"
+)
+
+// Profile represents the expected sections of a documentation page.
+type Profile struct {
+	// Ordered requires found sections to be in the same order as Sections
+	Ordered bool `yaml:"ordered"`
+
+	Sections []*ProfileSection `yaml:"sections"`
+}
+
+// ProfileSection represents an expected section of a documentation page.
+type ProfileSection struct {
+	// ContentTypes contains the allowed content types of the section: code_block,
+	// list, or paragraph. All content types are allowed if empty.
+	ContentTypes []string `yaml:"content_types"`
+
+	// Detect contains heading text prefixes that identify the section. If empty,
+	// the title section is identified by a heading containing the resource name
+	// and other sections by a heading matching Headings.
+	Detect []string `yaml:"detect"`
+
+	// HeadingPrefixes contains the allowed heading text prefixes
+	HeadingPrefixes []string `yaml:"heading_prefixes"`
+
+	// Headings contains the allowed heading texts
+	Headings []string `yaml:"headings"`
+
+	// Level is the expected heading level, if greater than zero
+	Level int `yaml:"level"`
+
+	// Name is the known section type: arguments, attributes, example, import, timeouts, or title
+	Name string `yaml:"name"`
+
+	Required bool `yaml:"required"`
+}
+
+// DefaultProfile returns the default Terraform Provider data source and resource documentation profile.
+func DefaultProfile() *Profile {
+	return &Profile{
+		Sections: []*ProfileSection{
+			{
+				ContentTypes:    []string{ContentTypeList, ContentTypeParagraph},
+				HeadingPrefixes: []string{"Resource: ", "Data Source: "},
+				Level:           1,
+				Name:            ProfileSectionTitle,
+				Required:        true,
+			},
+			{
+				Detect:   []string{"Example"},
+				Headings: []string{"Example Usage"},
+				Level:    2,
+				Name:     ProfileSectionExample,
+				Required: true,
+			},
+			{
+				Detect:   []string{"Argument"},
+				Headings: []string{"Argument Reference"},
+				Level:    2,
+				Name:     ProfileSectionArguments,
+				Required: true,
+			},
+			{
+				Detect:   []string{"Attribute"},
+				Headings: []string{"Attributes Reference"},
+				Level:    2,
+				Name:     ProfileSectionAttributes,
+				Required: true,
+			},
+			{
+				Detect: []string{"Timeout"},
+				Name:   ProfileSectionTimeouts,
+			},
+			{
+				Detect:   []string{"Import"},
+				Headings: []string{"Import"},
+				Level:    2,
+				Name:     ProfileSectionImport,
+			},
+		},
+	}
+}
+
+// Validate returns an error if the profile contains unknown section names or content types.
+func (p *Profile) Validate() error {
+	names := make(map[string]bool)
+
+	for _, section := range p.Sections {
+		switch section.Name {
+		case ProfileSectionArguments, ProfileSectionAttributes, ProfileSectionExample, ProfileSectionImport, ProfileSectionTimeouts, ProfileSectionTitle:
+		default:
+			return fmt.Errorf("unknown section name: %s", section.Name)
+		}
+
+		if names[section.Name] {
+			return fmt.Errorf("duplicate section name: %s", section.Name)
+		}
+
+		names[section.Name] = true
+
+		for _, contentType := range section.ContentTypes {
+			switch contentType {
+			case ContentTypeCodeBlock, ContentTypeList, ContentTypeParagraph:
+			default:
+				return fmt.Errorf("section (%s) has unknown content type: %s", section.Name, contentType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// section returns the profile section by name or nil.
+func (p *Profile) section(name string) *ProfileSection {
+	for _, section := range p.Sections {
+		if section.Name == name {
+			return section
+		}
+	}
+
+	return nil
+}
+
+// detects returns true if the heading text identifies the section.
+func (s *ProfileSection) detects(headingText string, resourceName string) bool {
+	for _, prefix := range s.Detect {
+		if strings.HasPrefix(headingText, prefix) {
+			return true
+		}
+	}
+
+	if len(s.Detect) > 0 {
+		return false
+	}
+
+	if s.Name == ProfileSectionTitle {
+		return strings.Contains(headingText, resourceName)
+	}
+
+	for _, heading := range s.Headings {
+		if headingText == heading {
+			return true
+		}
+	}
+
+	return false
+}
+
+// headingError returns an error if the heading does not match the expected level and text.
+func (s *ProfileSection) headingError(heading *ast.Heading, source []byte) error {
+	if s.Level > 0 && heading.Level != s.Level {
+		return fmt.Errorf("%s section heading level (%d) should be: %d", s.Name, heading.Level, s.Level)
+	}
+
+	headingText := string(heading.Text(source))
+
+	if len(s.Headings) > 0 && !slices.Contains(s.Headings, headingText) {
+		return fmt.Errorf("%s section heading (%s) should be: %s", s.Name, headingText, strings.Join(s.Headings, " or "))
+	}
+
+	if len(s.HeadingPrefixes) == 0 {
+		return nil
+	}
+
+	for _, prefix := range s.HeadingPrefixes {
+		if strings.HasPrefix(headingText, prefix) {
+			return nil
+		}
+	}
+
+	prefixes := make([]string, len(s.HeadingPrefixes))
+
+	for i, prefix := range s.HeadingPrefixes {
+		prefixes[i] = fmt.Sprintf("%q", prefix)
+	}
+
+	sort.Strings(prefixes)
+
+	return fmt.Errorf("%s section heading (%s) should have prefix: %s", s.Name, headingText, strings.Join(prefixes, " or "))
+}
+
+// missingError returns an error describing the missing section heading.
+func (s *ProfileSection) missingError(resourceName string) error {
+	level := s.Level
+
+	if level == 0 {
+		level = 2
+	}
+
+	heading := s.Name
+
+	switch {
+	case len(s.Headings) > 0:
+		heading = s.Headings[0]
+	case len(s.HeadingPrefixes) > 0:
+		heading = s.HeadingPrefixes[0] + resourceName
+	}
+
+	return fmt.Errorf("missing %s section: %s %s", s.Name, strings.Repeat("#", level), heading)
+}
+
+// contentTypesError returns an error if the section contains content types that are not allowed.
+func (s *ProfileSection) contentTypesError(codeBlocks int, lists int, paragraphs int) error {
+	if len(s.ContentTypes) == 0 {
+		return nil
+	}
+
+	if codeBlocks > 0 && !slices.Contains(s.ContentTypes, ContentTypeCodeBlock) {
+		if s.Name == ProfileSectionTitle {
+			return fmt.Errorf("title section code examples should be in Example Usage section")
+		}
+
+		return fmt.Errorf("%s section should not contain code blocks", s.Name)
+	}
+
+	if lists > 0 && !slices.Contains(s.ContentTypes, ContentTypeList) {
+		return fmt.Errorf("%s section should not contain lists", s.Name)
+	}
+
+	if paragraphs > 0 && !slices.Contains(s.ContentTypes, ContentTypeParagraph) {
+		return fmt.Errorf("%s section should not contain paragraphs", s.Name)
+	}
+
+	return nil
+}
+
+// checkProfileSection verifies the named section heading and content types
+// against the profile. It returns false if the section is not in the profile
+// or not found, so further section checks should be skipped.
+func (d *Document) checkProfileSection(name string) (bool, error) {
+	profileSection := d.profile().section(name)
+
+	if profileSection == nil {
+		return false, nil
+	}
+
+	contents := d.Sections.contents(name)
+
+	if contents == nil {
+		if profileSection.Required {
+			return false, profileSection.missingError(d.ResourceName)
+		}
+
+		return false, nil
+	}
+
+	if err := profileSection.headingError(contents.Heading, d.source); err != nil {
+		return false, err
+	}
+
+	if err := profileSection.contentTypesError(contents.FencedCodeBlocks, contents.Lists, contents.Paragraphs); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// checkSectionOrder verifies found sections are in profile order, if required.
+func (d *Document) checkSectionOrder() error {
+	profile := d.profile()
+
+	if !profile.Ordered {
+		return nil
+	}
+
+	var previous *ProfileSection
+	var previousStart int
+
+	for _, profileSection := range profile.Sections {
+		contents := d.Sections.contents(profileSection.Name)
+
+		if contents == nil || contents.Heading.Lines().Len() == 0 {
+			continue
+		}
+
+		start := contents.Heading.Lines().At(0).Start
+
+		if previous != nil && start < previousStart {
+			return fmt.Errorf("%s section should be after %s section", profileSection.Name, previous.Name)
+		}
+
+		previous = profileSection
+		previousStart = start
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package contents
+
+import (
+	"testing"
+)
+
+func TestDocumentProfile(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		ProviderName string
+		Profile      func() *Profile
+		ExpectError  bool
+	}{
+		{
+			Name:         "default profile",
+			Path:         "testdata/full.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "attribute reference heading default profile",
+			Path:         "testdata/profile/attribute_reference.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "attribute reference heading custom profile",
+			Path:         "testdata/profile/attribute_reference.md",
+			ProviderName: "test",
+			Profile: func() *Profile {
+				profile := DefaultProfile()
+				profile.section(ProfileSectionAttributes).Headings = []string{"Attribute Reference"}
+
+				return profile
+			},
+		},
+		{
+			Name:         "missing example default profile",
+			Path:         "testdata/profile/missing_example.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "missing example optional profile",
+			Path:         "testdata/profile/missing_example.md",
+			ProviderName: "test",
+			Profile: func() *Profile {
+				profile := DefaultProfile()
+				profile.section(ProfileSectionExample).Required = false
+
+				return profile
+			},
+		},
+		{
+			Name:         "wrong order default profile",
+			Path:         "testdata/profile/wrong_order.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "wrong order ordered profile",
+			Path:         "testdata/profile/wrong_order.md",
+			ProviderName: "test",
+			Profile: func() *Profile {
+				profile := DefaultProfile()
+				profile.Ordered = true
+
+				return profile
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "content types profile",
+			Path:         "testdata/full.md",
+			ProviderName: "test",
+			Profile: func() *Profile {
+				profile := DefaultProfile()
+				profile.section(ProfileSectionImport).ContentTypes = []string{ContentTypeParagraph}
+
+				return profile
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, testCase.ProviderName)
+
+			if testCase.Profile != nil {
+				doc.Profile = testCase.Profile()
+			}
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := doc.Check(nil)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestProfileValidate(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Profile     *Profile
+		ExpectError bool
+	}{
+		{
+			Name:    "default profile",
+			Profile: DefaultProfile(),
+		},
+		{
+			Name: "unknown section name",
+			Profile: &Profile{
+				Sections: []*ProfileSection{
+					{
+						Name: "schema",
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "duplicate section name",
+			Profile: &Profile{
+				Sections: []*ProfileSection{
+					{
+						Name: ProfileSectionTitle,
+					},
+					{
+						Name: ProfileSectionTitle,
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "unknown content type",
+			Profile: &Profile{
+				Sections: []*ProfileSection{
+					{
+						ContentTypes: []string{"table"},
+						Name:         ProfileSectionTitle,
+					},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Profile.Validate()
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package contents
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/yuin/goldmark/ast"
@@ -25,6 +26,10 @@ type SchemaAttributeListItem struct {
 	Type        string
 }
 
+// schemaAttributeListItemGeneratedRegexp matches generated (tfplugindocs) list
+// item text, e.g. name (String, Sensitive) Description
+var schemaAttributeListItemGeneratedRegexp = regexp.MustCompile(`^(\S+) \(([^,)]+),? ?([^)]*)\) ?(.*)$`)
+
 type SchemaAttributeListItemByName []*SchemaAttributeListItem
 
 func (item SchemaAttributeListItemByName) Len() int           { return len(item) }
@@ -62,6 +67,7 @@ func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*Sche
 	result := &SchemaAttributeListItem{}
 
 	// Expected format: `Name` - (Required/Optional[, ForceNew]) Description
+	// or generated (tfplugindocs) format: `Name` (Type[, Traits]) Description
 
 	err := ast.Walk(listItem, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
 		if !entering {
@@ -71,6 +77,24 @@ func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*Sche
 		switch node := node.(type) {
 		case *ast.TextBlock:
 			text := string(node.Text(source))
+
+			if generatedItem := schemaAttributeListItemGeneratedRegexp.FindStringSubmatch(text); generatedItem != nil {
+				result.Name = generatedItem[1]
+				result.Type = generatedItem[2]
+				result.Description = generatedItem[4]
+
+				for _, trait := range strings.Split(generatedItem[3], ", ") {
+					switch trait {
+					case "Optional":
+						result.Optional = true
+					case "Required":
+						result.Required = true
+					}
+				}
+
+				return ast.WalkStop, nil
+			}
+
 			itemParts := strings.SplitN(text, " - ", 2)
 
 			if len(itemParts) != 2 {
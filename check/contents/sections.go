@@ -1,8 +1,10 @@
 package contents
 
 import (
+	"sort"
 	"strings"
 
+	"github.com/bflad/tfproviderdocs/markdown"
 	"github.com/yuin/goldmark/ast"
 )
 
@@ -14,6 +16,19 @@ const (
 	walkerSectionAttributes
 	walkerSectionTimeouts
 	walkerSectionImport
+	walkerSectionSchema
+	walkerSectionSchemaArguments
+	walkerSectionSchemaAttributes
+	walkerSectionSchemaNestedArguments
+	walkerSectionSchemaNestedAttributes
+)
+
+const (
+	// SchemaHeadingText is the generated (tfplugindocs) schema section heading text
+	SchemaHeadingText = "Schema"
+
+	// SchemaNestedHeadingPrefix is the generated (tfplugindocs) nested schema subsection heading prefix
+	SchemaNestedHeadingPrefix = "Nested Schema for "
 )
 
 // Sections represents all expected sections of a resource documentation page
@@ -22,6 +37,7 @@ type Sections struct {
 	Arguments  *ArgumentsSection
 	Example    *ExampleSection
 	Import     *ImportSection
+	Schema     *SchemaSection
 	Timeouts   *TimeoutsSection
 	Title      *TitleSection
 }
@@ -39,6 +55,7 @@ type ExampleSection struct {
 
 	FencedCodeBlocks []*ast.FencedCodeBlock
 	Heading          *ast.Heading
+	Lists            []*ast.List
 	Paragraphs       []*ast.Paragraph
 }
 
@@ -46,6 +63,7 @@ type ExampleSection struct {
 type ImportSection struct {
 	FencedCodeBlocks []*ast.FencedCodeBlock
 	Heading          *ast.Heading
+	Lists            []*ast.List
 	Paragraphs       []*ast.Paragraph
 }
 
@@ -78,6 +96,38 @@ type SchemaAttributeSection struct {
 	Paragraphs []*ast.Paragraph
 }
 
+// SchemaSection represents a generated (tfplugindocs) resource schema section.
+//
+// The Required and Optional subsections are arguments and the Read-Only
+// subsection is attributes. Nested schema subsections are children of either,
+// based on their Required:, Optional:, or Read-Only: paragraphs.
+type SchemaSection struct {
+	Arguments        *ArgumentsSection
+	Attributes       *AttributesSection
+	FencedCodeBlocks []*ast.FencedCodeBlock
+	Heading          *ast.Heading
+	Lists            []*ast.List
+	Paragraphs       []*ast.Paragraph
+}
+
+// isSorted returns true if all schema attribute lists of the section and its
+// children are sorted by name.
+func (s *SchemaAttributeSection) isSorted() bool {
+	for _, list := range s.SchemaAttributeLists {
+		if !sort.IsSorted(SchemaAttributeListItemByName(list.Items)) {
+			return false
+		}
+	}
+
+	for _, child := range s.Children {
+		if !child.isSorted() {
+			return false
+		}
+	}
+
+	return true
+}
+
 // TimeoutsSection represents a resource timeouts section.
 type TimeoutsSection struct {
 	FencedCodeBlocks []*ast.FencedCodeBlock
@@ -90,114 +140,221 @@ type TimeoutsSection struct {
 type TitleSection struct {
 	FencedCodeBlocks []*ast.FencedCodeBlock
 	Heading          *ast.Heading
+	Lists            []*ast.List
 	Paragraphs       []*ast.Paragraph
 }
 
-func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sections, error) {
-	result := &Sections{}
+// start creates the named section with the heading, if not already found,
+// and returns the walker section or walkerSectionUnknown.
+func (s *Sections) start(name string, heading *ast.Heading) int {
+	switch name {
+	case ProfileSectionTitle:
+		if s.Title == nil {
+			s.Title = &TitleSection{Heading: heading}
+			return walkerSectionTitle
+		}
+	case ProfileSectionExample:
+		if s.Example == nil {
+			s.Example = &ExampleSection{Heading: heading}
+			return walkerSectionExample
+		}
+	case ProfileSectionArguments:
+		if s.Arguments == nil {
+			s.Arguments = &ArgumentsSection{Heading: heading}
+			return walkerSectionArguments
+		}
+	case ProfileSectionAttributes:
+		if s.Attributes == nil {
+			s.Attributes = &AttributesSection{Heading: heading}
+			return walkerSectionAttributes
+		}
+	case ProfileSectionTimeouts:
+		if s.Timeouts == nil {
+			s.Timeouts = &TimeoutsSection{Heading: heading}
+			return walkerSectionTimeouts
+		}
+	case ProfileSectionImport:
+		if s.Import == nil {
+			s.Import = &ImportSection{Heading: heading}
+			return walkerSectionImport
+		}
+	}
 
-	var walkerSectionStartingLevel, walkerSection int
+	return walkerSectionUnknown
+}
 
-	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
-		if !entering {
-			return ast.WalkContinue, nil
-		}
+// sectionContents represents the heading and number of each content type of a section.
+type sectionContents struct {
+	FencedCodeBlocks int
+	Heading          *ast.Heading
+	Lists            int
+	Paragraphs       int
+}
 
-		switch node := node.(type) {
-		case *ast.FencedCodeBlock:
-			switch walkerSection {
-			case walkerSectionTitle:
-				result.Title.FencedCodeBlocks = append(result.Title.FencedCodeBlocks, node)
-			case walkerSectionExample:
-				result.Example.FencedCodeBlocks = append(result.Example.FencedCodeBlocks, node)
-			case walkerSectionArguments:
-				result.Arguments.FencedCodeBlocks = append(result.Arguments.FencedCodeBlocks, node)
-			case walkerSectionAttributes:
-				result.Attributes.FencedCodeBlocks = append(result.Attributes.FencedCodeBlocks, node)
-			case walkerSectionTimeouts:
-				result.Timeouts.FencedCodeBlocks = append(result.Timeouts.FencedCodeBlocks, node)
-			case walkerSectionImport:
-				result.Import.FencedCodeBlocks = append(result.Import.FencedCodeBlocks, node)
-			}
+// contents returns the heading and content counts of the named section or nil if not found.
+func (s *Sections) contents(name string) *sectionContents {
+	switch {
+	case name == ProfileSectionTitle && s.Title != nil:
+		return &sectionContents{len(s.Title.FencedCodeBlocks), s.Title.Heading, len(s.Title.Lists), len(s.Title.Paragraphs)}
+	case name == ProfileSectionExample && s.Example != nil:
+		return &sectionContents{len(s.Example.FencedCodeBlocks), s.Example.Heading, len(s.Example.Lists), len(s.Example.Paragraphs)}
+	case name == ProfileSectionArguments && s.Arguments != nil:
+		return &sectionContents{len(s.Arguments.FencedCodeBlocks), s.Arguments.Heading, len(s.Arguments.Lists), len(s.Arguments.Paragraphs)}
+	case name == ProfileSectionAttributes && s.Attributes != nil:
+		return &sectionContents{len(s.Attributes.FencedCodeBlocks), s.Attributes.Heading, len(s.Attributes.Lists), len(s.Attributes.Paragraphs)}
+	case name == ProfileSectionTimeouts && s.Timeouts != nil:
+		return &sectionContents{len(s.Timeouts.FencedCodeBlocks), s.Timeouts.Heading, len(s.Timeouts.Lists), len(s.Timeouts.Paragraphs)}
+	case name == ProfileSectionImport && s.Import != nil:
+		return &sectionContents{len(s.Import.FencedCodeBlocks), s.Import.Heading, len(s.Import.Lists), len(s.Import.Paragraphs)}
+	}
+
+	return nil
+}
 
-			return ast.WalkSkipChildren, nil
-		case *ast.Heading:
-			headingText := string(node.Text(source))
-			//fmt.Printf("(walker section level: %d) found heading level %d: %s\n", walkerSectionStartingLevel, node.Level, headingText)
+// schemaSubsection returns the walker section for a generated schema
+// subsection heading, adding nested schema children as necessary.
+func (s *Sections) schemaSubsection(heading *ast.Heading, headingText string) int {
+	switch {
+	case headingText == "Required" || headingText == "Optional":
+		return walkerSectionSchemaArguments
+	case headingText == "Read-Only":
+		return walkerSectionSchemaAttributes
+	case strings.HasPrefix(headingText, SchemaNestedHeadingPrefix):
+		s.Schema.Arguments.Children = append(s.Schema.Arguments.Children, &SchemaAttributeSection{Heading: heading})
+		s.Schema.Attributes.Children = append(s.Schema.Attributes.Children, &SchemaAttributeSection{Heading: heading})
+
+		return walkerSectionSchemaNestedArguments
+	}
+
+	return walkerSectionUnknown
+}
 
-			// Always reset section handling when reaching starting level again
-			if node.Level == walkerSectionStartingLevel {
-				walkerSection = walkerSectionUnknown
-			}
+// schemaList adds the list to the current generated schema subsection.
+func (s *Sections) schemaList(walkerSection int, list *ast.List, source []byte) error {
+	var section *SchemaAttributeSection
 
-			if result.Title == nil && strings.Contains(headingText, resourceName) {
-				result.Title = &TitleSection{
-					Heading: node,
-				}
+	switch walkerSection {
+	case walkerSectionSchemaArguments:
+		section = (*SchemaAttributeSection)(s.Schema.Arguments)
+	case walkerSectionSchemaAttributes:
+		section = (*SchemaAttributeSection)(s.Schema.Attributes)
+	case walkerSectionSchemaNestedArguments:
+		section = s.Schema.Arguments.Children[len(s.Schema.Arguments.Children)-1]
+	case walkerSectionSchemaNestedAttributes:
+		section = s.Schema.Attributes.Children[len(s.Schema.Attributes.Children)-1]
+	}
 
-				walkerSection = walkerSectionTitle
-				walkerSectionStartingLevel = node.Level
+	s.Schema.Lists = append(s.Schema.Lists, list)
 
-				return ast.WalkContinue, nil
-			}
+	if section == nil {
+		return nil
+	}
 
-			if result.Example == nil && strings.HasPrefix(headingText, "Example") {
-				result.Example = &ExampleSection{
-					Heading: node,
-				}
+	schemaAttributeList, err := schemaAttributeListWalker(list, source)
 
-				walkerSection = walkerSectionExample
-				walkerSectionStartingLevel = node.Level
+	if err != nil {
+		return err
+	}
 
-				return ast.WalkContinue, nil
-			}
+	section.Lists = append(section.Lists, list)
+	section.SchemaAttributeLists = append(section.SchemaAttributeLists, schemaAttributeList)
 
-			if result.Arguments == nil && strings.HasPrefix(headingText, "Argument") {
-				result.Arguments = &ArgumentsSection{
-					Heading: node,
-				}
+	return nil
+}
 
-				walkerSection = walkerSectionArguments
-				walkerSectionStartingLevel = node.Level
+// isSchemaWalkerSection returns true if the walker section is within a generated schema section.
+func isSchemaWalkerSection(walkerSection int) bool {
+	switch walkerSection {
+	case walkerSectionSchema, walkerSectionSchemaArguments, walkerSectionSchemaAttributes, walkerSectionSchemaNestedArguments, walkerSectionSchemaNestedAttributes:
+		return true
+	}
 
-				return ast.WalkContinue, nil
-			}
+	return false
+}
 
-			if result.Attributes == nil && strings.HasPrefix(headingText, "Attribute") {
-				result.Attributes = &AttributesSection{
-					Heading: node,
-				}
+func sectionsWalker(document ast.Node, source []byte, resourceName string, profile *Profile) (*Sections, error) {
+	result := &Sections{}
 
-				walkerSection = walkerSectionAttributes
-				walkerSectionStartingLevel = node.Level
+	var walkerSectionStartingLevel, walkerSection int
 
-				return ast.WalkContinue, nil
+	err := markdown.WalkHeadings(document, func(node *ast.Heading) error {
+		headingText := string(node.Text(source))
+		//fmt.Printf("(walker section level: %d) found heading level %d: %s\n", walkerSectionStartingLevel, node.Level, headingText)
+
+		// Always reset section handling when reaching starting level again
+		if node.Level == walkerSectionStartingLevel {
+			walkerSection = walkerSectionUnknown
+		}
+
+		if isSchemaWalkerSection(walkerSection) && node.Level > walkerSectionStartingLevel {
+			walkerSection = result.schemaSubsection(node, headingText)
+
+			if walkerSection == walkerSectionUnknown {
+				walkerSection = walkerSectionSchema
 			}
 
-			if result.Timeouts == nil && strings.HasPrefix(headingText, "Timeout") {
-				result.Timeouts = &TimeoutsSection{
-					Heading: node,
-				}
+			return nil
+		}
 
-				walkerSection = walkerSectionTimeouts
-				walkerSectionStartingLevel = node.Level
+		for _, profileSection := range profile.Sections {
+			if !profileSection.detects(headingText, resourceName) {
+				continue
+			}
+
+			section := result.start(profileSection.Name, node)
 
-				return ast.WalkContinue, nil
+			if section == walkerSectionUnknown {
+				continue
 			}
 
-			if result.Import == nil && strings.HasPrefix(headingText, "Import") {
-				result.Import = &ImportSection{
-					Heading: node,
-				}
+			walkerSection = section
+			walkerSectionStartingLevel = node.Level
 
-				walkerSection = walkerSectionImport
-				walkerSectionStartingLevel = node.Level
+			return nil
+		}
 
-				return ast.WalkContinue, nil
+		// Generated schema sections are only handled when no profile section matches
+		if headingText == SchemaHeadingText && result.Schema == nil {
+			result.Schema = &SchemaSection{
+				Arguments:  &ArgumentsSection{Heading: node},
+				Attributes: &AttributesSection{Heading: node},
+				Heading:    node,
 			}
+			walkerSection = walkerSectionSchema
+			walkerSectionStartingLevel = node.Level
 
-			//fmt.Printf("(walker section level: %d) unknown heading level %d: %s\n", walkerSectionStartingLevel, node.Level, headingText)
-			walkerSection = walkerSectionUnknown
+			return nil
+		}
+
+		//fmt.Printf("(walker section level: %d) unknown heading level %d: %s\n", walkerSectionStartingLevel, node.Level, headingText)
+		walkerSection = walkerSectionUnknown
+
+		return nil
+	}, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.FencedCodeBlock:
+			switch walkerSection {
+			case walkerSectionTitle:
+				result.Title.FencedCodeBlocks = append(result.Title.FencedCodeBlocks, node)
+			case walkerSectionExample:
+				result.Example.FencedCodeBlocks = append(result.Example.FencedCodeBlocks, node)
+			case walkerSectionArguments:
+				result.Arguments.FencedCodeBlocks = append(result.Arguments.FencedCodeBlocks, node)
+			case walkerSectionAttributes:
+				result.Attributes.FencedCodeBlocks = append(result.Attributes.FencedCodeBlocks, node)
+			case walkerSectionTimeouts:
+				result.Timeouts.FencedCodeBlocks = append(result.Timeouts.FencedCodeBlocks, node)
+			case walkerSectionImport:
+				result.Import.FencedCodeBlocks = append(result.Import.FencedCodeBlocks, node)
+			default:
+				if isSchemaWalkerSection(walkerSection) {
+					result.Schema.FencedCodeBlocks = append(result.Schema.FencedCodeBlocks, node)
+				}
+			}
 
 			return ast.WalkSkipChildren, nil
 		case *ast.List:
@@ -222,8 +379,20 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				}
 
 				result.Attributes.SchemaAttributeLists = append(result.Attributes.SchemaAttributeLists, schemaAttributeList)
+			case walkerSectionTitle:
+				result.Title.Lists = append(result.Title.Lists, node)
+			case walkerSectionExample:
+				result.Example.Lists = append(result.Example.Lists, node)
 			case walkerSectionTimeouts:
 				result.Timeouts.Lists = append(result.Timeouts.Lists, node)
+			case walkerSectionImport:
+				result.Import.Lists = append(result.Import.Lists, node)
+			default:
+				if isSchemaWalkerSection(walkerSection) {
+					if err := result.schemaList(walkerSection, node, source); err != nil {
+						return ast.WalkStop, err
+					}
+				}
 			}
 
 			return ast.WalkSkipChildren, nil
@@ -241,6 +410,19 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				result.Timeouts.Paragraphs = append(result.Timeouts.Paragraphs, node)
 			case walkerSectionImport:
 				result.Import.Paragraphs = append(result.Import.Paragraphs, node)
+			case walkerSectionSchemaNestedArguments, walkerSectionSchemaNestedAttributes:
+				result.Schema.Paragraphs = append(result.Schema.Paragraphs, node)
+
+				// Nested schema lists are grouped by Required:, Optional:, and Read-Only: paragraphs
+				if string(node.Text(source)) == "Read-Only:" {
+					walkerSection = walkerSectionSchemaNestedAttributes
+				} else {
+					walkerSection = walkerSectionSchemaNestedArguments
+				}
+			default:
+				if isSchemaWalkerSection(walkerSection) {
+					result.Schema.Paragraphs = append(result.Schema.Paragraphs, node)
+				}
 			}
 
 			return ast.WalkSkipChildren, nil
@@ -0,0 +1,110 @@
+package contents
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSectionsWalkerSchema(t *testing.T) {
+	doc := NewDocument("testdata/full_generated.md", "test")
+
+	if err := doc.Parse(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if doc.Sections.Schema == nil {
+		t.Fatalf("expected schema section, got none")
+	}
+
+	testCases := []struct {
+		Name    string
+		Section *SchemaAttributeSection
+		Expect  [][]*SchemaAttributeListItem
+	}{
+		{
+			Name:    "arguments",
+			Section: (*SchemaAttributeSection)(doc.Sections.Schema.Arguments),
+			Expect: [][]*SchemaAttributeListItem{
+				{
+					{Description: "Name of thing.", Name: "name", Type: "String"},
+				},
+				{
+					{Description: "Settings of thing. (see below for nested schema)", Name: "setting", Type: "Block List"},
+					{Description: "Key-value map of resource tags - applied to the thing.", Name: "tags", Type: "Map of String"},
+				},
+			},
+		},
+		{
+			Name:    "attributes",
+			Section: (*SchemaAttributeSection)(doc.Sections.Schema.Attributes),
+			Expect: [][]*SchemaAttributeListItem{
+				{
+					{Description: "Name of thing.", Name: "id", Type: "String"},
+				},
+			},
+		},
+		{
+			Name:    "nested arguments",
+			Section: doc.Sections.Schema.Arguments.Children[0],
+			Expect: [][]*SchemaAttributeListItem{
+				{
+					{Description: "Key of setting.", Name: "key", Type: "String"},
+				},
+				{
+					{Description: "Value of setting.", Name: "value", Type: "String"},
+				},
+			},
+		},
+		{
+			Name:    "nested attributes",
+			Section: doc.Sections.Schema.Attributes.Children[0],
+			Expect: [][]*SchemaAttributeListItem{
+				{
+					{Description: "Creation time of setting.", Name: "created", Type: "String"},
+					{Description: "Update time of setting.", Name: "updated", Type: "String"},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got [][]*SchemaAttributeListItem
+
+			for _, list := range testCase.Section.SchemaAttributeLists {
+				got = append(got, list.Items)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected %#v, got %#v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestSectionsWalkerSchemaHeading(t *testing.T) {
+	doc := NewDocument("testdata/sections/schema_heading.md", "test")
+	doc.Profile = DefaultProfile()
+	doc.Profile.section(ProfileSectionArguments).Detect = []string{"Schema"}
+	doc.Profile.section(ProfileSectionArguments).Headings = []string{"Schema"}
+
+	if err := doc.Parse(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if doc.Sections.Schema != nil {
+		t.Errorf("expected no schema section, got: %#v", doc.Sections.Schema)
+	}
+
+	if doc.Sections.Arguments == nil {
+		t.Fatalf("expected arguments section, got none")
+	}
+
+	if got, expected := len(doc.Sections.Arguments.Lists), 1; got != expected {
+		t.Errorf("expected %d arguments lists, got %d", expected, got)
+	}
+
+	if err := doc.Check(nil); err != nil {
+		t.Errorf("expected no error, got error: %s", err)
+	}
+}
@@ -2,16 +2,14 @@ package check
 
 import (
 	"fmt"
-	"log"
+	"io/fs"
 	"path/filepath"
-
-	"github.com/bmatcuk/doublestar"
 )
 
 const (
 	CdktfIndexDirectory = `cdktf`
 
-	DocumentationGlobPattern = `{docs/index.md,docs/{,cdktf/}{data-sources,guides,resources},website/docs}/**/*`
+	DocumentationGlobPattern = `{docs/index.md,{docs/{,cdktf/*/}{data-sources,guides,resources},website/docs}/**/*}`
 
 	LegacyIndexDirectory       = `website/docs`
 	LegacyDataSourcesDirectory = `d`
@@ -114,47 +112,32 @@ func MixedDirectoriesCheck(directories map[string][]string) error {
 // NumberOfFilesCheck verifies that documentation is below the Terraform Registry storage limit.
 // This check presumes that all provided directories are valid, e.g. that directory checking
 // for invalid or mixed directory structures was previously completed.
+//
+// The limit applies separately to each CDKTF language, since each language is
+// presumably one CDKTF file per source HCL file.
 func NumberOfFilesCheck(directories map[string][]string) error {
-	var numberOfFiles int
-
-	for directory, files := range directories {
-		// Ignore CDKTF files. The file limit is per-language and presumably there is one CDKTF file per source HCL file.
-		if IsValidCdktfDirectory(directory) {
-			continue
-		}
-
-		directoryNumberOfFiles := len(files)
-		log.Printf("[TRACE] Found %d documentation files in directory: %s", directoryNumberOfFiles, directory)
-		numberOfFiles = numberOfFiles + directoryNumberOfFiles
-	}
-
-	log.Printf("[DEBUG] Found %d documentation files with limit of %d", numberOfFiles, RegistryMaximumNumberOfFiles)
-	if numberOfFiles >= RegistryMaximumNumberOfFiles {
-		return fmt.Errorf("exceeded maximum (%d) number of documentation files for Terraform Registry: %d", RegistryMaximumNumberOfFiles, numberOfFiles)
-	}
-
-	return nil
+	return NewLimitsCheck(nil).numberOfFilesError(directories)
 }
 
 func GetDirectories(basepath string) (map[string][]string, error) {
-	globPattern := DocumentationGlobPattern
+	return GetDirectoriesFS(nil, basepath)
+}
 
-	if basepath != "" {
-		globPattern = fmt.Sprintf("%s/%s", basepath, globPattern)
+// GetDirectoriesFS returns the documentation files by directory of the base
+// path within the file system, such as a release archive. If the file system
+// is nil, the operating system file system is used.
+func GetDirectoriesFS(fsys fs.FS, basepath string) (map[string][]string, error) {
+	fileOpts := &FileOptions{
+		BasePath: basepath,
+		FS:       fsys,
 	}
 
-	files, err := doublestar.Glob(globPattern)
+	files, err := fileOpts.Glob(DocumentationGlobPattern)
 
 	if err != nil {
 		return nil, fmt.Errorf("error globbing Terraform Provider documentation directories: %w", err)
 	}
 
-	if basepath != "" {
-		for index, file := range files {
-			files[index], _ = filepath.Rel(basepath, file)
-		}
-	}
-
 	directories := make(map[string][]string)
 
 	for _, file := range files {
@@ -2,7 +2,10 @@ package check
 
 import (
 	"fmt"
+	"io/fs"
+	"reflect"
 	"testing"
+	"testing/fstest"
 )
 
 func TestNumberOfFilesCheck(t *testing.T) {
@@ -25,6 +28,32 @@ func TestNumberOfFilesCheck(t *testing.T) {
 			Directories: testGenerateDirectories(RegistryMaximumNumberOfFiles + 1),
 			ExpectError: true,
 		},
+		{
+			Name: "cdktf languages under limit",
+			Directories: map[string][]string{
+				"docs/resources":                  testGenerateFiles(RegistryMaximumNumberOfFiles - 1),
+				"docs/cdktf/python/resources":     testGenerateFiles(RegistryMaximumNumberOfFiles - 1),
+				"docs/cdktf/typescript/resources": testGenerateFiles(RegistryMaximumNumberOfFiles - 1),
+			},
+		},
+		{
+			Name: "cdktf language at limit",
+			Directories: map[string][]string{
+				"docs/resources":                     testGenerateFiles(1),
+				"docs/cdktf/python/resources":        testGenerateFiles(1),
+				"docs/cdktf/typescript/data-sources": testGenerateFiles(RegistryMaximumNumberOfFiles / 2),
+				"docs/cdktf/typescript/resources":    testGenerateFiles(RegistryMaximumNumberOfFiles / 2),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "legacy cdktf language over limit",
+			Directories: map[string][]string{
+				"website/docs/r":                  testGenerateFiles(1),
+				"website/docs/cdktf/typescript/r": testGenerateFiles(RegistryMaximumNumberOfFiles + 1),
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -42,16 +71,117 @@ func TestNumberOfFilesCheck(t *testing.T) {
 	}
 }
 
+func TestGetDirectoriesFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"terraform-provider-test/CHANGELOG.md":                                {},
+		"terraform-provider-test/docs/index.md":                               {},
+		"terraform-provider-test/docs/cdktf/python/resources/thing.md":        {},
+		"terraform-provider-test/docs/data-sources/thing.md":                  {},
+		"terraform-provider-test/docs/resources/thing.md":                     {},
+		"terraform-provider-test/docs/resources/thing2.md":                    {},
+		"terraform-provider-test/examples/resources/test_thing/resource.tf":   {},
+		"terraform-provider-test/website/docs/r/thing.html.markdown":          {},
+		"terraform-provider-other/docs/resources/other.md":                    {},
+		"terraform-provider-test/templates/resources/thing.md.tmpl":           {},
+		"terraform-provider-test/docs/cdktf/typescript/data-sources/thing.md": {},
+	}
+
+	testCases := []struct {
+		Name     string
+		FS       fs.FS
+		BasePath string
+		Expect   map[string][]string
+	}{
+		{
+			Name:     "base path",
+			FS:       fsys,
+			BasePath: "terraform-provider-test",
+			Expect: map[string][]string{
+				"docs":                               {"docs/index.md"},
+				"docs/cdktf/python/resources":        {"docs/cdktf/python/resources/thing.md"},
+				"docs/cdktf/typescript/data-sources": {"docs/cdktf/typescript/data-sources/thing.md"},
+				"docs/data-sources":                  {"docs/data-sources/thing.md"},
+				"docs/resources":                     {"docs/resources/thing.md", "docs/resources/thing2.md"},
+				"website/docs/r":                     {"website/docs/r/thing.html.markdown"},
+			},
+		},
+		{
+			Name:     "missing base path",
+			FS:       fsys,
+			BasePath: "terraform-provider-missing",
+			Expect:   map[string][]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := GetDirectoriesFS(testCase.FS, testCase.BasePath)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected %#v, got %#v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
 func testGenerateDirectories(numberOfFiles int) map[string][]string {
+	directories := map[string][]string{
+		"docs/resources": testGenerateFiles(numberOfFiles),
+	}
+
+	return directories
+}
+
+func testGenerateFiles(numberOfFiles int) []string {
 	files := make([]string, numberOfFiles)
 
 	for i := 0; i < numberOfFiles; i++ {
 		files[i] = fmt.Sprintf("thing%d.md", i)
 	}
 
-	directories := map[string][]string{
-		"docs/resources": files,
+	return files
+}
+
+func TestGetDirectories(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		BasePath string
+		Expect   map[string][]string
+	}{
+		{
+			Name:     "registry index file",
+			BasePath: "testdata/valid-registry-directories",
+			Expect: map[string][]string{
+				"docs": {"docs/index.md"},
+			},
+		},
+		{
+			Name:     "registry cdktf language directories",
+			BasePath: "testdata/valid-registry-directories-with-cdktf",
+			Expect: map[string][]string{
+				"docs/cdktf/typescript/data-sources": {"docs/cdktf/typescript/data-sources/thing.md"},
+				"docs/cdktf/typescript/resources":    {"docs/cdktf/typescript/resources/thing.md"},
+			},
+		},
 	}
 
-	return directories
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := GetDirectories(testCase.BasePath)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for directory, want := range testCase.Expect {
+				if !reflect.DeepEqual(got[directory], want) {
+					t.Errorf("expected directory %s files %v, got %v", directory, want, got[directory])
+				}
+			}
+		})
+	}
 }
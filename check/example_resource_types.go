@@ -0,0 +1,119 @@
+package check
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/yuin/goldmark/ast"
+)
+
+type ExampleResourceTypesCheck struct {
+	Options *ExampleResourceTypesOptions
+}
+
+// ExampleResourceTypesOptions represents configuration options for ExampleResourceTypes.
+type ExampleResourceTypesOptions struct {
+	DataSourceNames []string
+	Enable          bool
+	ProviderName    string
+	ResourceNames   []string
+}
+
+func NewExampleResourceTypesCheck(opts *ExampleResourceTypesOptions) *ExampleResourceTypesCheck {
+	check := &ExampleResourceTypesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ExampleResourceTypesOptions{}
+	}
+
+	return check
+}
+
+// Run verifies that all provider data source and resource types in terraform
+// and hcl code blocks of the Markdown source are known.
+//
+// Types without the provider name prefix and code blocks with HCL syntax errors are skipped.
+func (check *ExampleResourceTypesCheck) Run(src []byte) error {
+	if !check.Options.Enable || check.Options.ProviderName == "" {
+		return nil
+	}
+
+	document, _ := markdown.Parse(src)
+
+	var result *multierror.Error
+
+	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		fencedCodeBlock, ok := node.(*ast.FencedCodeBlock)
+
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		language := markdown.FencedCodeBlockLanguage(fencedCodeBlock, src)
+
+		if language != markdown.FencedCodeBlockLanguageHcl && language != markdown.FencedCodeBlockLanguageTerraform {
+			return ast.WalkSkipChildren, nil
+		}
+
+		for _, err := range check.codeBlockErrors(fencedCodeBlock, src) {
+			result = multierror.Append(result, err)
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (check *ExampleResourceTypesCheck) codeBlockErrors(fcb *ast.FencedCodeBlock, src []byte) []error {
+	text := markdown.FencedCodeBlockRawText(fcb, src)
+	file, diags := hclsyntax.ParseConfig([]byte(text), "", hcl.InitialPos)
+
+	if diags.HasErrors() {
+		return nil
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+
+	if !ok {
+		return nil
+	}
+
+	var result []error
+
+	for _, block := range body.Blocks {
+		if len(block.Labels) == 0 || !strings.HasPrefix(block.Labels[0], check.Options.ProviderName+"_") {
+			continue
+		}
+
+		line := markdown.FencedCodeBlockLineNumber(fcb, src) + block.LabelRanges[0].Start.Line - 1
+
+		switch block.Type {
+		case "data":
+			if !slices.Contains(check.Options.DataSourceNames, block.Labels[0]) {
+				result = append(result, fmt.Errorf("line %d: %s type (%s) not found in provider schema", line, ResourceTypeDataSource, block.Labels[0]))
+			}
+		case "resource":
+			if !slices.Contains(check.Options.ResourceNames, block.Labels[0]) {
+				result = append(result, fmt.Errorf("line %d: %s type (%s) not found in provider schema", line, ResourceTypeResource, block.Labels[0]))
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,87 @@
+package check
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExampleResourceTypesCheck(t *testing.T) {
+	testOptions := &ExampleResourceTypesOptions{
+		DataSourceNames: []string{"test_data_source"},
+		Enable:          true,
+		ProviderName:    "test",
+		ResourceNames:   []string{"test_resource"},
+	}
+
+	testCases := []struct {
+		Name        string
+		Source      string
+		Options     *ExampleResourceTypesOptions
+		ExpectError string
+	}{
+		{
+			Name: "disabled",
+			Source: "```terraform\n" +
+				"resource \"test_removed\" \"example\" {}\n" +
+				"```\n",
+		},
+		{
+			Name: "known types",
+			Source: "```terraform\n" +
+				"data \"test_data_source\" \"example\" {}\n" +
+				"\n" +
+				"resource \"test_resource\" \"example\" {}\n" +
+				"\n" +
+				"resource \"other_resource\" \"example\" {}\n" +
+				"```\n",
+			Options: testOptions,
+		},
+		{
+			Name: "unknown data source type",
+			Source: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"data \"test_removed\" \"example\" {}\n" +
+				"```\n",
+			Options:     testOptions,
+			ExpectError: "line 4: data source type (test_removed)",
+		},
+		{
+			Name: "unknown resource type",
+			Source: "# Example\n" +
+				"\n" +
+				"```hcl\n" +
+				"resource \"test_resource\" \"example\" {}\n" +
+				"\n" +
+				"resource \"test_removed\" \"example\" {}\n" +
+				"```\n",
+			Options:     testOptions,
+			ExpectError: "line 6: resource type (test_removed)",
+		},
+		{
+			Name: "unknown resource type in non-HCL code block",
+			Source: "```console\n" +
+				"$ terraform import test_removed.example example\n" +
+				"```\n",
+			Options: testOptions,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewExampleResourceTypesCheck(testCase.Options).Run([]byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError != "" {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && testCase.ExpectError == "" {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got != nil && !strings.Contains(got.Error(), testCase.ExpectError) {
+				t.Errorf("expected error containing %q, got error: %s", testCase.ExpectError, got)
+			}
+		})
+	}
+}
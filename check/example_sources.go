@@ -0,0 +1,175 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/yuin/goldmark/ast"
+)
+
+type ExampleSourcesCheck struct {
+	Options *ExampleSourcesOptions
+}
+
+// ExampleSourcesOptions represents configuration options for ExampleSources.
+type ExampleSourcesOptions struct {
+	*FileOptions
+
+	Enable       bool
+	ProviderName string
+}
+
+func NewExampleSourcesCheck(opts *ExampleSourcesOptions) *ExampleSourcesCheck {
+	check := &ExampleSourcesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ExampleSourcesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies that the first example code block of data source and resource
+// documentation files matches the tfplugindocs example file, e.g.
+// examples/resources/NAME/resource.tf, after HCL formatting.
+//
+// Files without an example code block or example file are skipped.
+func (check *ExampleSourcesCheck) Run(directories map[string][]string) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for _, directory := range sortedDirectories(directories) {
+		var exampleFile string
+
+		switch directory {
+		case RegistryIndexDirectory + "/" + RegistryDataSourcesDirectory, LegacyIndexDirectory + "/" + LegacyDataSourcesDirectory:
+			exampleFile = ExamplesDirectory + "/" + RegistryDataSourcesDirectory + "/%s/data-source.tf"
+		case RegistryIndexDirectory + "/" + RegistryResourcesDirectory, LegacyIndexDirectory + "/" + LegacyResourcesDirectory:
+			exampleFile = ExamplesDirectory + "/" + RegistryResourcesDirectory + "/%s/resource.tf"
+		default:
+			continue
+		}
+
+		for _, file := range directories[directory] {
+			if err := check.RunFile(file, fmt.Sprintf(exampleFile, fileResourceName(check.Options.ProviderName, file))); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies a single documentation file against the example file.
+func (check *ExampleSourcesCheck) RunFile(path string, exampleFile string) error {
+	exampleContent, err := check.Options.ReadFile(exampleFile)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Printf("[DEBUG] Skipping %s example source check, example file not found: %s", path, exampleFile)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading example file (%s): %w", path, exampleFile, err)
+	}
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	fencedCodeBlock := exampleSourcesCodeBlock(content)
+
+	if fencedCodeBlock == nil {
+		return nil
+	}
+
+	got := exampleSourcesNormalize(markdown.FencedCodeBlockText(fencedCodeBlock, content))
+	want := exampleSourcesNormalize(string(exampleContent))
+
+	if got == want {
+		return nil
+	}
+
+	gotLines := strings.Split(got, "\n")
+	wantLines := strings.Split(want, "\n")
+	var line int
+
+	for line < len(gotLines) && line < len(wantLines) && gotLines[line] == wantLines[line] {
+		line++
+	}
+
+	return fmt.Errorf("%s: line %d: example code block does not match example file (%s), first difference at example file line: %d", path, markdown.FencedCodeBlockLineNumber(fencedCodeBlock, content), filepath.ToSlash(exampleFile), line+1)
+}
+
+// exampleSourcesCodeBlock returns the first code block after the first
+// example heading of the Markdown source or nil.
+func exampleSourcesCodeBlock(src []byte) *ast.FencedCodeBlock {
+	document, _ := markdown.Parse(src)
+
+	var exampleHeading bool
+	var result *ast.FencedCodeBlock
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.Heading:
+			if strings.HasPrefix(string(node.Text(src)), "Example") {
+				exampleHeading = true
+			}
+
+			return ast.WalkSkipChildren, nil
+		case *ast.FencedCodeBlock:
+			if !exampleHeading {
+				return ast.WalkSkipChildren, nil
+			}
+
+			result = node
+
+			return ast.WalkStop, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return result
+}
+
+// exampleSourcesNormalize returns the text in canonical HCL format, if it can
+// be parsed, without trailing whitespace on each line or surrounding whitespace.
+func exampleSourcesNormalize(text string) string {
+	src := []byte(strings.ReplaceAll(text, "\r\n", "\n"))
+
+	if _, diags := hclwrite.ParseConfig(src, "", hcl.InitialPos); !diags.HasErrors() {
+		src = hclwrite.Format(src)
+	}
+
+	lines := strings.Split(string(src), "\n")
+
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
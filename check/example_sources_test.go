@@ -0,0 +1,89 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestExampleSourcesCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Options      *ExampleSourcesOptions
+		ExpectErrors int
+	}{
+		{
+			Name:    "disabled",
+			Options: &ExampleSourcesOptions{},
+		},
+		{
+			Name: "enabled",
+			Options: &ExampleSourcesOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectErrors: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			basePath := "testdata/example-sources"
+			directories, err := GetDirectories(basePath)
+
+			if err != nil {
+				t.Fatalf("error getting directories: %s", err)
+			}
+
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: basePath,
+			}
+
+			got := NewExampleSourcesCheck(testCase.Options).Run(directories)
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
+
+func TestExampleSourcesNormalize(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Text   string
+		Expect string
+	}{
+		{
+			Name:   "canonical",
+			Text:   "resource \"test_thing\" \"example\" {\n  name = \"example\"\n}\n",
+			Expect: "resource \"test_thing\" \"example\" {\n  name = \"example\"\n}",
+		},
+		{
+			Name:   "not canonical",
+			Text:   "\nresource \"test_thing\" \"example\" {\r\n     name=\"example\"   \r\n}\r\n\r\n",
+			Expect: "resource \"test_thing\" \"example\" {\n  name = \"example\"\n}",
+		},
+		{
+			Name:   "syntax error",
+			Text:   "resource \"test_thing\" \"example\" {  \n  name =\n",
+			Expect: "resource \"test_thing\" \"example\" {\n  name =",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := exampleSourcesNormalize(testCase.Text)
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
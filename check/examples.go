@@ -0,0 +1,248 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+const (
+	// ExamplesGlobPattern matches tfplugindocs example Terraform configuration files
+	ExamplesGlobPattern = `examples/**/*.tf`
+
+	ExamplesImportFile = `import.sh`
+)
+
+// examplesImportCommandRegexp matches terraform import commands and their resource address
+var examplesImportCommandRegexp = regexp.MustCompile(`terraform\s+import\s+(?:-\S+\s+)*(\S+)`)
+
+// examplesImportModuleRegexp matches module prefixes of resource addresses
+var examplesImportModuleRegexp = regexp.MustCompile(`^(?:module\.[^.]+\.)+`)
+
+type ExamplesCheck struct {
+	Options *ExamplesOptions
+}
+
+// ExamplesOptions represents configuration options for Examples.
+type ExamplesOptions struct {
+	*FileOptions
+
+	DataSourceFileMismatch *FileMismatchOptions
+	Enable                 bool
+	ResourceFileMismatch   *FileMismatchOptions
+}
+
+func NewExamplesCheck(opts *ExamplesOptions) *ExamplesCheck {
+	check := &ExamplesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ExamplesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the tfplugindocs examples directory: data source and resource
+// example directories match known data sources and resources, Terraform
+// configuration files have no HCL syntax errors, and resource import scripts
+// reference the resource type.
+func (check *ExamplesCheck) Run() error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for _, subdirectory := range []string{RegistryDataSourcesDirectory, RegistryResourcesDirectory} {
+		if err := check.directoryMismatchError(subdirectory); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if err := check.hclSyntaxError(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := check.importScriptsError(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// directoryMismatchError returns an error for extraneous and missing example
+// directories of the data-sources or resources subdirectory.
+func (check *ExamplesCheck) directoryMismatchError(subdirectory string) error {
+	var opts *FileMismatchOptions
+
+	switch subdirectory {
+	case RegistryDataSourcesDirectory:
+		opts = check.Options.DataSourceFileMismatch
+	case RegistryResourcesDirectory:
+		opts = check.Options.ResourceFileMismatch
+	}
+
+	if opts == nil || len(opts.ResourceNames) == 0 {
+		log.Printf("[DEBUG] Skipping %s example directory mismatch checks, no %s names", subdirectory, subdirectory)
+		return nil
+	}
+
+	directory := ExamplesDirectory + "/" + subdirectory
+	names, err := check.subdirectoryNames(directory)
+
+	if err != nil {
+		return err
+	}
+
+	mismatchCheck := NewFileMismatchCheck(opts)
+	var result *multierror.Error
+
+	for _, name := range names {
+		if slices.Contains(opts.ResourceNames, name) || slices.Contains(opts.IgnoreFileMismatch, name) {
+			continue
+		}
+
+		err := fmt.Errorf("%s/%s: matching %s for example directory not found, directory is extraneous or incorrectly named", directory, name, opts.ResourceType)
+		result = multierror.Append(result, err)
+	}
+
+	for _, resourceName := range opts.ResourceNames {
+		if slices.Contains(names, resourceName) || mismatchCheck.IgnoreFileMissing(resourceName) {
+			continue
+		}
+
+		err := fmt.Errorf("missing example directory for %s: %s", opts.ResourceType, resourceName)
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// hclSyntaxError returns an error for each HCL syntax error in example
+// Terraform configuration files.
+func (check *ExamplesCheck) hclSyntaxError() error {
+	files, err := check.glob(ExamplesGlobPattern)
+
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+
+	for _, file := range files {
+		content, err := check.Options.ReadFile(file)
+
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("%s: error reading file: %w", file, err))
+			continue
+		}
+
+		_, diags := hclsyntax.ParseConfig(content, file, hcl.InitialPos)
+
+		for _, diag := range diags {
+			if diag.Severity != hcl.DiagError {
+				continue
+			}
+
+			var line int
+
+			if diag.Subject != nil {
+				line = diag.Subject.Start.Line
+			}
+
+			result = multierror.Append(result, fmt.Errorf("%s: line %d: %s", file, line, diag.Summary))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// importScriptsError returns an error for each resource import script that
+// does not import the resource type of its example directory.
+func (check *ExamplesCheck) importScriptsError() error {
+	files, err := check.glob(ExamplesDirectory + "/" + RegistryResourcesDirectory + "/*/" + ExamplesImportFile)
+
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+
+	for _, file := range files {
+		content, err := check.Options.ReadFile(file)
+
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("%s: error reading file: %w", file, err))
+			continue
+		}
+
+		resourceName := filepath.Base(filepath.Dir(file))
+		matches := examplesImportCommandRegexp.FindAllStringSubmatch(string(content), -1)
+
+		if len(matches) == 0 {
+			result = multierror.Append(result, fmt.Errorf("%s: missing terraform import command for resource: %s", file, resourceName))
+			continue
+		}
+
+		for _, match := range matches {
+			address := examplesImportModuleRegexp.ReplaceAllString(strings.Trim(match[1], `'"`), "")
+			resourceType := strings.SplitN(address, ".", 2)[0]
+
+			if resourceType != resourceName {
+				result = multierror.Append(result, fmt.Errorf("%s: terraform import address (%s) resource type should be: %s", file, match[1], resourceName))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// glob returns the files matching the pattern relative to the base path.
+func (check *ExamplesCheck) glob(pattern string) ([]string, error) {
+	files, err := check.Options.Glob(pattern)
+
+	if err != nil {
+		return nil, fmt.Errorf("error globbing Terraform Provider examples: %w", err)
+	}
+
+	return files, nil
+}
+
+// subdirectoryNames returns the sorted names of subdirectories in the
+// directory, or none if the directory does not exist.
+func (check *ExamplesCheck) subdirectoryNames(directory string) ([]string, error) {
+	entries, err := check.Options.ReadDir(directory)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading examples directory (%s): %w", directory, err)
+	}
+
+	var names []string
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,94 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestExamplesCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		BasePath     string
+		Options      *ExamplesOptions
+		ExpectErrors int
+	}{
+		{
+			Name:     "disabled",
+			BasePath: "testdata/invalid-examples",
+			Options:  &ExamplesOptions{},
+		},
+		{
+			Name:     "valid examples",
+			BasePath: "testdata/valid-examples",
+			Options: &ExamplesOptions{
+				DataSourceFileMismatch: &FileMismatchOptions{
+					ResourceType:  ResourceTypeDataSource,
+					ResourceNames: []string{"test_thing"},
+				},
+				Enable: true,
+				ResourceFileMismatch: &FileMismatchOptions{
+					ResourceType:  ResourceTypeResource,
+					ResourceNames: []string{"test_other", "test_thing"},
+				},
+			},
+		},
+		{
+			Name:     "valid examples without schema",
+			BasePath: "testdata/valid-examples",
+			Options: &ExamplesOptions{
+				Enable: true,
+			},
+		},
+		{
+			Name:     "invalid examples",
+			BasePath: "testdata/invalid-examples",
+			Options: &ExamplesOptions{
+				DataSourceFileMismatch: &FileMismatchOptions{
+					ResourceType:  ResourceTypeDataSource,
+					ResourceNames: []string{"test_thing"},
+				},
+				Enable: true,
+				ResourceFileMismatch: &FileMismatchOptions{
+					ResourceType:  ResourceTypeResource,
+					ResourceNames: []string{"test_missing", "test_syntax", "test_thing"},
+				},
+			},
+			ExpectErrors: 6,
+		},
+		{
+			Name:     "invalid examples ignored",
+			BasePath: "testdata/invalid-examples",
+			Options: &ExamplesOptions{
+				Enable: true,
+				ResourceFileMismatch: &FileMismatchOptions{
+					IgnoreFileMismatch: []string{"test_unknown"},
+					IgnoreFileMissing:  []string{"test_missing"},
+					ResourceType:       ResourceTypeResource,
+					ResourceNames:      []string{"test_missing", "test_syntax", "test_thing"},
+				},
+			},
+			ExpectErrors: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: testCase.BasePath,
+			}
+
+			got := NewExamplesCheck(testCase.Options).Run()
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package check
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+)
+
+// ExternalLinkChecker verifies an external link URL, such as against a recorded
+// allowlist or a local mirror, without requiring access to the URL itself.
+type ExternalLinkChecker interface {
+	CheckExternalLink(link string) error
+}
+
+// ExternalLinks returns external link URLs found in documentation files with
+// the sorted, deduplicated list of files using each URL.
+func ExternalLinks(directories map[string][]string, fileOpts *FileOptions) (map[string][]string, error) {
+	if fileOpts == nil {
+		fileOpts = &FileOptions{}
+	}
+
+	result := make(map[string][]string)
+
+	for _, files := range directories {
+		for _, file := range files {
+			content, err := fileOpts.ReadFile(file)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: error reading file: %w", file, err)
+			}
+
+			document, _ := markdown.Parse(content)
+
+			for _, link := range markdown.ExternalLinks(document, content) {
+				if !slices.Contains(result[link], file) {
+					result[link] = append(result[link], file)
+				}
+			}
+		}
+	}
+
+	for _, files := range result {
+		sort.Strings(files)
+	}
+
+	return result, nil
+}
+
+// AllowlistExternalLinkChecker verifies external links against a list of
+// allowed URLs. Entries ending with * allow all URLs with that prefix.
+type AllowlistExternalLinkChecker struct {
+	Allowed []string
+}
+
+// NewAllowlistExternalLinkChecker returns an AllowlistExternalLinkChecker from
+// a newline separated file. Empty lines and lines starting with # are ignored.
+func NewAllowlistExternalLinkChecker(path string) (*AllowlistExternalLinkChecker, error) {
+	log.Printf("[DEBUG] Loading external link allowlist file: %s", path)
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening external link allowlist file (%s): %w", path, err)
+	}
+
+	defer file.Close()
+
+	checker := &AllowlistExternalLinkChecker{}
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		checker.Allowed = append(checker.Allowed, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading external link allowlist file (%s): %w", path, err)
+	}
+
+	return checker, nil
+}
+
+func (checker *AllowlistExternalLinkChecker) CheckExternalLink(link string) error {
+	for _, allowed := range checker.Allowed {
+		if prefix := strings.TrimSuffix(allowed, "*"); prefix != allowed && strings.HasPrefix(link, prefix) {
+			return nil
+		}
+
+		if link == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("not found in allowlist")
+}
+
+// HttpExternalLinkChecker verifies external links with an HTTP endpoint, such
+// as a local mirror or link validation service.
+//
+// Each URL is checked with a GET request to the endpoint with the URL in the
+// url query parameter, e.g. http://localhost:8080/check?url=https%3A%2F%2Fexample.com.
+// Any 2xx response status code is considered valid.
+type HttpExternalLinkChecker struct {
+	Client   *http.Client
+	Endpoint string
+}
+
+func NewHttpExternalLinkChecker(endpoint string) *HttpExternalLinkChecker {
+	return &HttpExternalLinkChecker{
+		Client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		Endpoint: endpoint,
+	}
+}
+
+func (checker *HttpExternalLinkChecker) CheckExternalLink(link string) error {
+	endpoint, err := url.Parse(checker.Endpoint)
+
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint (%s): %w", checker.Endpoint, err)
+	}
+
+	query := endpoint.Query()
+	query.Set("url", link)
+	endpoint.RawQuery = query.Encode()
+
+	resp, err := checker.Client.Get(endpoint.String())
+
+	if err != nil {
+		return fmt.Errorf("error checking with endpoint (%s): %w", checker.Endpoint, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("endpoint (%s) returned status: %s", checker.Endpoint, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package check
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExternalLinks(t *testing.T) {
+	basePath := "testdata/valid-links"
+	directories, err := GetDirectories(basePath)
+
+	if err != nil {
+		t.Fatalf("error getting directories: %s", err)
+	}
+
+	got, err := ExternalLinks(directories, &FileOptions{BasePath: basePath})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string][]string{
+		"https://www.terraform.io/docs/configuration/resources.html": {"docs/index.md"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestAllowlistExternalLinkChecker(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "allowlist.txt")
+	content := "# comment\n\nhttps://example.com/exact\nhttps://www.terraform.io/*\n"
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("error writing allowlist file: %s", err)
+	}
+
+	checker, err := NewAllowlistExternalLinkChecker(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name        string
+		Link        string
+		ExpectError bool
+	}{
+		{
+			Name: "exact",
+			Link: "https://example.com/exact",
+		},
+		{
+			Name: "prefix",
+			Link: "https://www.terraform.io/docs/configuration/resources.html",
+		},
+		{
+			Name:        "not allowed",
+			Link:        "https://example.com/exact/other",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := checker.CheckExternalLink(testCase.Link)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestHttpExternalLinkChecker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("url") != "https://example.com/valid" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	checker := NewHttpExternalLinkChecker(server.URL + "/check")
+
+	if err := checker.CheckExternalLink("https://example.com/valid"); err != nil {
+		t.Errorf("expected no error, got error: %s", err)
+	}
+
+	if err := checker.CheckExternalLink("https://example.com/invalid"); err == nil {
+		t.Errorf("expected error, got no error")
+	}
+}
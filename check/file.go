@@ -1,10 +1,17 @@
 package check
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar"
 )
 
 type FileCheck interface {
@@ -14,6 +21,15 @@ type FileCheck interface {
 
 type FileOptions struct {
 	BasePath string
+
+	// FS is the file system containing the documentation files, such as a
+	// release archive or in-memory tree. BasePath is a directory within it.
+	// Defaults to the operating system file system.
+	FS fs.FS
+
+	// MaximumSizeOfFile is the documentation file size limit in bytes,
+	// defaulting to RegistryMaximumSizeOfFile.
+	MaximumSizeOfFile int
 }
 
 func (opts *FileOptions) FullPath(path string) string {
@@ -24,17 +40,158 @@ func (opts *FileOptions) FullPath(path string) string {
 	return path
 }
 
+// Glob returns the sorted files and directories matching the doublestar
+// pattern, relative to the base path.
+func (opts *FileOptions) Glob(pattern string) ([]string, error) {
+	if opts.FS == nil {
+		globPattern := pattern
+
+		if opts.BasePath != "" {
+			globPattern = fmt.Sprintf("%s/%s", opts.BasePath, pattern)
+		}
+
+		files, err := doublestar.Glob(globPattern)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for index, file := range files {
+			if opts.BasePath != "" {
+				files[index], _ = filepath.Rel(opts.BasePath, file)
+			}
+
+			files[index] = filepath.ToSlash(files[index])
+		}
+
+		sort.Strings(files)
+
+		return files, nil
+	}
+
+	root := opts.fsPath(".")
+
+	var files []string
+
+	err := fs.WalkDir(opts.FS, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if name == root {
+			return nil
+		}
+
+		file := name
+
+		if root != "." {
+			file = strings.TrimPrefix(name, root+"/")
+		}
+
+		match, err := doublestar.Match(pattern, file)
+
+		if err != nil {
+			return err
+		}
+
+		if match {
+			files = append(files, file)
+		}
+
+		return nil
+	})
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// ReadDir returns the directory entries of the path relative to the base path.
+func (opts *FileOptions) ReadDir(path string) ([]fs.DirEntry, error) {
+	if opts.FS == nil {
+		return os.ReadDir(opts.FullPath(path))
+	}
+
+	return fs.ReadDir(opts.FS, opts.fsPath(path))
+}
+
+// ReadFile returns the contents of the path relative to the base path.
+func (opts *FileOptions) ReadFile(path string) ([]byte, error) {
+	if opts.FS == nil {
+		return os.ReadFile(opts.FullPath(path))
+	}
+
+	return fs.ReadFile(opts.FS, opts.fsPath(path))
+}
+
+// SizeLimit returns the documentation file size limit in bytes.
+func (opts *FileOptions) SizeLimit() int {
+	if opts.MaximumSizeOfFile > 0 {
+		return opts.MaximumSizeOfFile
+	}
+
+	return RegistryMaximumSizeOfFile
+}
+
+// Stat returns the file information of the path relative to the base path.
+func (opts *FileOptions) Stat(path string) (fs.FileInfo, error) {
+	if opts.FS == nil {
+		return os.Stat(opts.FullPath(path))
+	}
+
+	return fs.Stat(opts.FS, opts.fsPath(path))
+}
+
+// fileExists returns true if the path relative to the base path is an existing file.
+func (opts *FileOptions) fileExists(path string) bool {
+	fi, err := opts.Stat(path)
+
+	return err == nil && !fi.IsDir()
+}
+
+// fileSizeError returns an error if the file at the path relative to the base
+// path is not below the size limit.
+func (opts *FileOptions) fileSizeError(path string) error {
+	fi, err := opts.Stat(path)
+
+	if err != nil {
+		return err
+	}
+
+	return fileSizeLimitError(opts.FullPath(path), fi.Size(), opts.SizeLimit())
+}
+
+// fsPath returns the path relative to the base path as a file system path.
+func (opts *FileOptions) fsPath(name string) string {
+	return path.Join(filepath.ToSlash(opts.BasePath), filepath.ToSlash(name))
+}
+
 // FileSizeCheck verifies that documentation file is below the Terraform Registry storage limit.
 func FileSizeCheck(fullpath string) error {
+	return FileSizeLimitCheck(fullpath, RegistryMaximumSizeOfFile)
+}
+
+// FileSizeLimitCheck verifies that documentation file is below the size limit in bytes.
+func FileSizeLimitCheck(fullpath string, limit int) error {
 	fi, err := os.Stat(fullpath)
 
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[DEBUG] File %s size: %d (limit: %d)", fullpath, fi.Size(), RegistryMaximumSizeOfFile)
-	if fi.Size() >= int64(RegistryMaximumSizeOfFile) {
-		return fmt.Errorf("exceeded maximum (%d) size of documentation file for Terraform Registry: %d", RegistryMaximumSizeOfFile, fi.Size())
+	return fileSizeLimitError(fullpath, fi.Size(), limit)
+}
+
+func fileSizeLimitError(fullpath string, size int64, limit int) error {
+	log.Printf("[DEBUG] File %s size: %d (limit: %d)", fullpath, size, limit)
+	if size >= int64(limit) {
+		return fmt.Errorf("exceeded maximum (%d) size of documentation file for Terraform Registry: %d", limit, size)
 	}
 
 	return nil
@@ -54,6 +54,58 @@ func TestFileSizeCheck(t *testing.T) {
 	}
 }
 
+func TestFileSizeLimitCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Limit       int
+		Size        int64
+		ExpectError bool
+	}{
+		{
+			Name:  "under limit",
+			Limit: 1000,
+			Size:  999,
+		},
+		{
+			Name:        "on limit",
+			Limit:       1000,
+			Size:        1000,
+			ExpectError: true,
+		},
+		{
+			Name:  "over registry limit under custom limit",
+			Limit: RegistryMaximumSizeOfFile * 2,
+			Size:  RegistryMaximumSizeOfFile + 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			file, err := os.CreateTemp(os.TempDir(), "TestFileSizeLimitCheck")
+
+			if err != nil {
+				t.Fatalf("error creating temporary file: %s", err)
+			}
+
+			defer os.Remove(file.Name())
+
+			if err := file.Truncate(testCase.Size); err != nil {
+				t.Fatalf("error writing temporary file: %s", err)
+			}
+
+			got := FileSizeLimitCheck(file.Name(), testCase.Limit)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
 func TestFullPath(t *testing.T) {
 	testCases := []struct {
 		Name        string
@@ -88,3 +140,34 @@ func TestFullPath(t *testing.T) {
 		})
 	}
 }
+
+func TestSizeLimit(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		FileOptions *FileOptions
+		Expect      int
+	}{
+		{
+			Name:        "default",
+			FileOptions: &FileOptions{},
+			Expect:      RegistryMaximumSizeOfFile,
+		},
+		{
+			Name: "maximum size of file",
+			FileOptions: &FileOptions{
+				MaximumSizeOfFile: 1000,
+			},
+			Expect: 1000,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.FileOptions.SizeLimit()
+
+			if got != testCase.Expect {
+				t.Errorf("expected %d, got %d", testCase.Expect, got)
+			}
+		})
+	}
+}
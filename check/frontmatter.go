@@ -2,10 +2,74 @@ package check
 
 import (
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/yuin/goldmark/ast"
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// PageTitleTemplateResourceName is replaced with the resource name from the file path in page_title templates
+	PageTitleTemplateResourceName = "<resource_name>"
+
+	// PageTitleTemplateSubcategory is replaced with the frontmatter subcategory in page_title templates
+	PageTitleTemplateSubcategory = "<Subcategory>"
+)
+
+const (
+	FrontMatterKeyTypeBool   = "bool"
+	FrontMatterKeyTypeInt    = "int"
+	FrontMatterKeyTypeList   = "list"
+	FrontMatterKeyTypeMap    = "map"
+	FrontMatterKeyTypeString = "string"
+)
+
+// legacyFrontMatterSchema is the default schema for legacy data source, function, guide, and resource files
+var legacyFrontMatterSchema = FrontMatterSchema{
+	"description": {Required: true},
+	"layout":      {Required: true},
+	"page_title":  {Required: true},
+	"subcategory": {},
+}
+
+// legacyIndexFrontMatterSchema is the default schema for legacy index files
+var legacyIndexFrontMatterSchema = FrontMatterSchema{
+	"description": {Required: true},
+	"layout":      {Required: true},
+	"page_title":  {Required: true},
+}
+
+// registryFrontMatterSchema is the default schema for Terraform Registry data source, function, and resource files
+var registryFrontMatterSchema = FrontMatterSchema{
+	"description": {},
+	"page_title":  {},
+	"subcategory": {},
+}
+
+// registryGuideFrontMatterSchema is the default schema for Terraform Registry guide files
+var registryGuideFrontMatterSchema = FrontMatterSchema{
+	"description": {},
+	"page_title":  {Required: true},
+	"subcategory": {},
+}
+
+// registryIndexFrontMatterSchema is the default schema for Terraform Registry index files
+var registryIndexFrontMatterSchema = FrontMatterSchema{
+	"description": {},
+	"page_title":  {},
+}
+
+// descriptionSentenceBoundaryRegexp matches the end of a sentence followed by another sentence
+var descriptionSentenceBoundaryRegexp = regexp.MustCompile(`[.!?]\s+[A-Z]`)
+
+// descriptionWordRegexp matches words for description similarity
+var descriptionWordRegexp = regexp.MustCompile(`[a-z0-9_]+`)
+
 type FrontMatterCheck struct {
 	Options *FrontMatterOptions
 }
@@ -19,18 +83,59 @@ type FrontMatterData struct {
 	Subcategory    *string `yaml:"subcategory,omitempty"`
 }
 
+// FrontMatterKeySchema represents the expectations of a YAML frontmatter key.
+type FrontMatterKeySchema struct {
+	Required bool `yaml:"required"`
+
+	// Type is the expected value type: bool, int, list, map, or string. Defaults to string.
+	Type string `yaml:"type"`
+}
+
+// FrontMatterSchema represents all allowed YAML frontmatter keys.
+type FrontMatterSchema map[string]*FrontMatterKeySchema
+
+// Merge returns a new schema with the keys of both schemas, preferring the other schema.
+func (schema FrontMatterSchema) Merge(other FrontMatterSchema) FrontMatterSchema {
+	result := make(FrontMatterSchema, len(schema)+len(other))
+
+	for key, keySchema := range schema {
+		result[key] = keySchema
+	}
+
+	for key, keySchema := range other {
+		result[key] = keySchema
+	}
+
+	return result
+}
+
 // FrontMatterOptions represents configuration options for FrontMatter.
 type FrontMatterOptions struct {
 	AllowedSubcategories []string
-	NoDescription        bool
-	NoLayout             bool
-	NoPageTitle          bool
-	NoSidebarCurrent     bool
-	NoSubcategory        bool
-	RequireDescription   bool
-	RequireLayout        bool
-	RequirePageTitle     bool
-	RequireSubcategory   bool
+
+	// DescriptionMaxLength is the maximum number of description characters, if greater than zero
+	DescriptionMaxLength int
+
+	// DescriptionSimilarityThreshold is the minimum similarity (0 to 1) between
+	// the description and first paragraph after the title heading, if greater than zero
+	DescriptionSimilarityThreshold float64
+
+	NoDescriptionPageTitle bool
+
+	// PageTitleTemplate is the expected page_title, if not empty, with
+	// <resource_name> and <Subcategory> placeholders replaced for each file,
+	// e.g. <Subcategory>: <resource_name>
+	PageTitleTemplate string
+
+	// ProviderName is used to determine the resource name from the file path
+	ProviderName string
+
+	RequireDescriptionResourceName   bool
+	RequireDescriptionSingleSentence bool
+
+	// Schema contains all allowed keys, if not nil. Unknown keys, missing
+	// required keys, and values of the wrong type are reported.
+	Schema FrontMatterSchema
 }
 
 func NewFrontMatterCheck(opts *FrontMatterOptions) *FrontMatterCheck {
@@ -45,7 +150,11 @@ func NewFrontMatterCheck(opts *FrontMatterOptions) *FrontMatterCheck {
 	return check
 }
 
-func (check *FrontMatterCheck) Run(src []byte) error {
+// Run verifies the YAML frontmatter of the Markdown source.
+//
+// The path is used to determine the resource name for description and
+// page_title template checks.
+func (check *FrontMatterCheck) Run(path string, src []byte) error {
 	frontMatter := FrontMatterData{}
 
 	err := yaml.Unmarshal([]byte(src), &frontMatter)
@@ -53,44 +162,64 @@ func (check *FrontMatterCheck) Run(src []byte) error {
 		return fmt.Errorf("error parsing YAML frontmatter: %w", err)
 	}
 
-	if check.Options.NoDescription && frontMatter.Description != nil {
-		return fmt.Errorf("YAML frontmatter should not contain description")
+	if check.Options.Schema != nil {
+		if err := check.schemaError(src); err != nil {
+			return err
+		}
 	}
 
-	if check.Options.NoLayout && frontMatter.Layout != nil {
-		return fmt.Errorf("YAML frontmatter should not contain layout")
+	if len(check.Options.AllowedSubcategories) > 0 && frontMatter.Subcategory != nil && !isAllowedSubcategory(*frontMatter.Subcategory, check.Options.AllowedSubcategories) {
+		return fmt.Errorf("YAML frontmatter subcategory (%s) does not match allowed subcategories (%#v)", *frontMatter.Subcategory, check.Options.AllowedSubcategories)
 	}
 
-	if check.Options.NoPageTitle && frontMatter.PageTitle != nil {
-		return fmt.Errorf("YAML frontmatter should not contain page_title")
+	if check.Options.PageTitleTemplate != "" && frontMatter.PageTitle != nil {
+		if err := check.pageTitleError(path, frontMatter); err != nil {
+			return err
+		}
 	}
 
-	if check.Options.NoSidebarCurrent && frontMatter.SidebarCurrent != nil {
-		return fmt.Errorf("YAML frontmatter should not contain sidebar_current")
+	if frontMatter.Description != nil {
+		if err := check.descriptionError(path, src, frontMatter); err != nil {
+			return err
+		}
 	}
 
-	if check.Options.NoSubcategory && frontMatter.Subcategory != nil {
-		return fmt.Errorf("YAML frontmatter should not contain subcategory")
-	}
+	return nil
+}
+
+func (check *FrontMatterCheck) descriptionError(path string, src []byte, frontMatter FrontMatterData) error {
+	description := strings.TrimSpace(*frontMatter.Description)
 
-	if check.Options.RequireDescription && frontMatter.Description == nil {
-		return fmt.Errorf("YAML frontmatter missing required description")
+	if max := check.Options.DescriptionMaxLength; max > 0 && utf8.RuneCountInString(description) > max {
+		return fmt.Errorf("YAML frontmatter description length (%d) should be at most: %d", utf8.RuneCountInString(description), max)
 	}
 
-	if check.Options.RequireLayout && frontMatter.Layout == nil {
-		return fmt.Errorf("YAML frontmatter missing required layout")
+	if check.Options.RequireDescriptionSingleSentence && descriptionSentenceBoundaryRegexp.MatchString(description) {
+		return fmt.Errorf("YAML frontmatter description should be a single sentence: %s", description)
 	}
 
-	if check.Options.RequirePageTitle && frontMatter.PageTitle == nil {
-		return fmt.Errorf("YAML frontmatter missing required page_title")
+	if check.Options.NoDescriptionPageTitle && frontMatter.PageTitle != nil && strings.EqualFold(strings.TrimSuffix(description, "."), strings.TrimSpace(*frontMatter.PageTitle)) {
+		return fmt.Errorf("YAML frontmatter description should not duplicate page_title: %s", description)
 	}
 
-	if check.Options.RequireSubcategory && frontMatter.Subcategory == nil {
-		return fmt.Errorf("YAML frontmatter missing required subcategory")
+	if check.Options.RequireDescriptionResourceName && check.Options.ProviderName != "" && path != "" {
+		resourceName := fileResourceName(check.Options.ProviderName, filepath.Base(path))
+
+		if !strings.Contains(description, resourceName) {
+			return fmt.Errorf("YAML frontmatter description should mention: %s", resourceName)
+		}
 	}
 
-	if len(check.Options.AllowedSubcategories) > 0 && frontMatter.Subcategory != nil && !isAllowedSubcategory(*frontMatter.Subcategory, check.Options.AllowedSubcategories) {
-		return fmt.Errorf("YAML frontmatter subcategory (%s) does not match allowed subcategories (%#v)", *frontMatter.Subcategory, check.Options.AllowedSubcategories)
+	if threshold := check.Options.DescriptionSimilarityThreshold; threshold > 0 {
+		paragraph := titleParagraphText(src)
+
+		if paragraph == "" {
+			return nil
+		}
+
+		if similarity := descriptionSimilarity(description, paragraph); similarity < threshold {
+			return fmt.Errorf("YAML frontmatter description similarity (%.2f) to first title paragraph should be at least: %.2f", similarity, threshold)
+		}
 	}
 
 	return nil
@@ -105,3 +234,180 @@ func isAllowedSubcategory(subcategory string, allowedSubcategories []string) boo
 
 	return false
 }
+
+func (check *FrontMatterCheck) schemaError(src []byte) error {
+	values := make(map[string]interface{})
+
+	if err := yaml.Unmarshal(src, &values); err != nil {
+		return fmt.Errorf("error parsing YAML frontmatter: %w", err)
+	}
+
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keySchema, ok := check.Options.Schema[key]
+
+		if !ok {
+			return fmt.Errorf("YAML frontmatter contains unknown key (%s), allowed keys: %s", key, strings.Join(check.Options.Schema.keys(), ", "))
+		}
+
+		// Empty values are treated as missing
+		if values[key] == nil {
+			continue
+		}
+
+		if keySchema != nil && !isFrontMatterKeyType(values[key], keySchema.Type) {
+			return fmt.Errorf("YAML frontmatter %s should be type: %s", key, keySchema.typeName())
+		}
+	}
+
+	for _, key := range check.Options.Schema.keys() {
+		if keySchema := check.Options.Schema[key]; keySchema != nil && keySchema.Required && values[key] == nil {
+			return fmt.Errorf("YAML frontmatter missing required %s", key)
+		}
+	}
+
+	return nil
+}
+
+func (check *FrontMatterCheck) pageTitleError(path string, frontMatter FrontMatterData) error {
+	expected := check.Options.PageTitleTemplate
+
+	if strings.Contains(expected, PageTitleTemplateResourceName) {
+		if path == "" {
+			return nil
+		}
+
+		expected = strings.ReplaceAll(expected, PageTitleTemplateResourceName, fileResourceName(check.Options.ProviderName, filepath.Base(path)))
+	}
+
+	if strings.Contains(expected, PageTitleTemplateSubcategory) {
+		if frontMatter.Subcategory == nil {
+			return fmt.Errorf("YAML frontmatter missing subcategory for page_title template: %s", check.Options.PageTitleTemplate)
+		}
+
+		expected = strings.ReplaceAll(expected, PageTitleTemplateSubcategory, *frontMatter.Subcategory)
+	}
+
+	if *frontMatter.PageTitle != expected {
+		return fmt.Errorf("YAML frontmatter page_title (%s) should be: %s", *frontMatter.PageTitle, expected)
+	}
+
+	return nil
+}
+
+// keys returns the sorted keys of the schema.
+func (schema FrontMatterSchema) keys() []string {
+	result := make([]string, 0, len(schema))
+
+	for key := range schema {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func (keySchema *FrontMatterKeySchema) typeName() string {
+	if keySchema.Type == "" {
+		return FrontMatterKeyTypeString
+	}
+
+	return keySchema.Type
+}
+
+func isFrontMatterKeyType(value interface{}, keyType string) bool {
+	switch keyType {
+	case FrontMatterKeyTypeBool:
+		_, ok := value.(bool)
+		return ok
+	case FrontMatterKeyTypeInt:
+		_, ok := value.(int)
+		return ok
+	case FrontMatterKeyTypeList:
+		_, ok := value.([]interface{})
+		return ok
+	case FrontMatterKeyTypeMap:
+		_, ok := value.(map[interface{}]interface{})
+		return ok
+	default:
+		_, ok := value.(string)
+		return ok
+	}
+}
+
+// descriptionSimilarity returns the Sørensen–Dice coefficient (0 to 1) of the
+// unique lowercase words in both texts.
+func descriptionSimilarity(a string, b string) float64 {
+	wordsA := descriptionWords(a)
+	wordsB := descriptionWords(b)
+
+	if len(wordsA)+len(wordsB) == 0 {
+		return 1
+	}
+
+	var common int
+
+	for word := range wordsA {
+		if wordsB[word] {
+			common++
+		}
+	}
+
+	return float64(2*common) / float64(len(wordsA)+len(wordsB))
+}
+
+func descriptionWords(text string) map[string]bool {
+	words := make(map[string]bool)
+
+	for _, word := range descriptionWordRegexp.FindAllString(strings.ToLower(text), -1) {
+		words[word] = true
+	}
+
+	return words
+}
+
+// titleParagraphText returns the text of the first paragraph after the first
+// level 1 heading of the Markdown source or an empty string.
+func titleParagraphText(src []byte) string {
+	document, _ := markdown.Parse(src)
+
+	var foundTitle bool
+	var result string
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.Heading:
+			if foundTitle {
+				return ast.WalkStop, nil
+			}
+
+			foundTitle = node.Level == 1
+
+			return ast.WalkSkipChildren, nil
+		case *ast.Paragraph:
+			if foundTitle {
+				result = string(node.Text(src))
+
+				return ast.WalkStop, nil
+			}
+
+			return ast.WalkSkipChildren, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return result
+}
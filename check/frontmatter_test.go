@@ -7,6 +7,7 @@ import (
 func TestFrontMatterCheck(t *testing.T) {
 	testCases := []struct {
 		Name        string
+		Path        string
 		Source      string
 		Options     *FrontMatterOptions
 		ExpectError bool
@@ -76,7 +77,7 @@ subcategory: Example Subcategory
 			ExpectError: true,
 		},
 		{
-			Name: "no description option",
+			Name: "schema option matching",
 			Source: `
 description: |-
   Example description
@@ -85,12 +86,34 @@ page_title: Example Page Title
 subcategory: Example Subcategory
 `,
 			Options: &FrontMatterOptions{
-				NoDescription: true,
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"page_title":  {},
+					"subcategory": {},
+				},
+			},
+		},
+		{
+			Name: "schema option unknown description",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+subcategory: Example Subcategory
+`,
+			Options: &FrontMatterOptions{
+				Schema: FrontMatterSchema{
+					"layout":      {},
+					"page_title":  {},
+					"subcategory": {},
+				},
 			},
 			ExpectError: true,
 		},
 		{
-			Name: "no layout option",
+			Name: "schema option unknown layout",
 			Source: `
 description: |-
   Example description
@@ -99,12 +122,16 @@ page_title: Example Page Title
 subcategory: Example Subcategory
 `,
 			Options: &FrontMatterOptions{
-				NoLayout: true,
+				Schema: FrontMatterSchema{
+					"description": {},
+					"page_title":  {},
+					"subcategory": {},
+				},
 			},
 			ExpectError: true,
 		},
 		{
-			Name: "no page_title option",
+			Name: "schema option unknown page_title",
 			Source: `
 description: |-
   Example description
@@ -113,53 +140,90 @@ page_title: Example Page Title
 subcategory: Example Subcategory
 `,
 			Options: &FrontMatterOptions{
-				NoPageTitle: true,
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"subcategory": {},
+				},
 			},
 			ExpectError: true,
 		},
 		{
-			Name: "no sidebar_current option",
+			Name: "schema option unknown subcategory",
 			Source: `
 description: |-
   Example description
 layout: "example"
 page_title: Example Page Title
-sidebar_current: "example_resource"
 subcategory: Example Subcategory
 `,
 			Options: &FrontMatterOptions{
-				NoSidebarCurrent: true,
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"page_title":  {},
+				},
 			},
 			ExpectError: true,
 		},
 		{
-			Name: "no subcategory option",
+			Name: "schema option unknown sidebar_current",
 			Source: `
 description: |-
   Example description
 layout: "example"
+sidebar_current: "example_resource"
 page_title: Example Page Title
 subcategory: Example Subcategory
 `,
 			Options: &FrontMatterOptions{
-				NoSubcategory: true,
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"page_title":  {},
+					"subcategory": {},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "schema option unknown misspelled key",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+subcatgory: Example Subcategory
+`,
+			Options: &FrontMatterOptions{
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"page_title":  {},
+					"subcategory": {},
+				},
 			},
 			ExpectError: true,
 		},
 		{
-			Name: "require description option",
+			Name: "schema option require description",
 			Source: `
 layout: "example"
 page_title: Example Page Title
 subcategory: Example Subcategory
 `,
 			Options: &FrontMatterOptions{
-				RequireDescription: true,
+				Schema: FrontMatterSchema{
+					"description": {Required: true},
+					"layout":      {},
+					"page_title":  {},
+					"subcategory": {},
+				},
 			},
 			ExpectError: true,
 		},
 		{
-			Name: "require layout option",
+			Name: "schema option require layout",
 			Source: `
 description: |-
   Example description
@@ -167,12 +231,17 @@ page_title: Example Page Title
 subcategory: Example Subcategory
 `,
 			Options: &FrontMatterOptions{
-				RequireLayout: true,
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {Required: true},
+					"page_title":  {},
+					"subcategory": {},
+				},
 			},
 			ExpectError: true,
 		},
 		{
-			Name: "require page_title option",
+			Name: "schema option require page_title",
 			Source: `
 description: |-
   Example description
@@ -180,20 +249,223 @@ layout: "example"
 subcategory: Example Subcategory
 `,
 			Options: &FrontMatterOptions{
-				RequirePageTitle: true,
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"page_title":  {Required: true},
+					"subcategory": {},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "schema option require subcategory",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"page_title":  {},
+					"subcategory": {Required: true},
+				},
 			},
 			ExpectError: true,
 		},
 		{
-			Name: "require subcategory option",
+			Name: "schema option additional keys matching",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+subcategory: Example Subcategory
+nav_title: Example
+weight: 10
+`,
+			Options: &FrontMatterOptions{
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"nav_title":   {Type: FrontMatterKeyTypeString},
+					"page_title":  {},
+					"subcategory": {},
+					"weight":      {Type: FrontMatterKeyTypeInt},
+				},
+			},
+		},
+		{
+			Name: "schema option additional keys wrong type",
 			Source: `
 description: |-
   Example description
 layout: "example"
 page_title: Example Page Title
+subcategory: Example Subcategory
+nav_title:
+  - Example
+weight: 10
+`,
+			Options: &FrontMatterOptions{
+				Schema: FrontMatterSchema{
+					"description": {},
+					"layout":      {},
+					"nav_title":   {Type: FrontMatterKeyTypeString},
+					"page_title":  {},
+					"subcategory": {},
+					"weight":      {Type: FrontMatterKeyTypeInt},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "description max length option",
+			Source: `
+description: |-
+  Example description
+`,
+			Options: &FrontMatterOptions{
+				DescriptionMaxLength: 10,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "description max length option non-ASCII characters",
+			Source: `
+description: |-
+  Gère übèr thé
+`,
+			Options: &FrontMatterOptions{
+				DescriptionMaxLength: 13,
+			},
+		},
+		{
+			Name: "description single sentence option matching",
+			Source: `
+description: |-
+  Manages an example thing, e.g. for testing.
+`,
+			Options: &FrontMatterOptions{
+				RequireDescriptionSingleSentence: true,
+			},
+		},
+		{
+			Name: "description single sentence option not matching",
+			Source: `
+description: |-
+  Manages an example thing. Also does other things.
+`,
+			Options: &FrontMatterOptions{
+				RequireDescriptionSingleSentence: true,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "no description page title option",
+			Source: `
+description: |-
+  Example Page Title.
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				NoDescriptionPageTitle: true,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "description resource name option matching",
+			Path: "docs/resources/thing.md",
+			Source: `
+description: |-
+  Manages an example_thing.
+`,
+			Options: &FrontMatterOptions{
+				ProviderName:                   "example",
+				RequireDescriptionResourceName: true,
+			},
+		},
+		{
+			Name: "description resource name option not matching",
+			Path: "docs/resources/thing.md",
+			Source: `
+description: |-
+  Manages an example thing.
+`,
+			Options: &FrontMatterOptions{
+				ProviderName:                   "example",
+				RequireDescriptionResourceName: true,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "description similarity threshold option matching",
+			Source: `---
+description: |-
+  Manages an example thing.
+---
+
+# Resource: example_thing
+
+Manages an example thing within the example service.
+`,
+			Options: &FrontMatterOptions{
+				DescriptionSimilarityThreshold: 0.5,
+			},
+		},
+		{
+			Name: "description similarity threshold option not matching",
+			Source: `---
+description: |-
+  Provides a widget.
+---
+
+# Resource: example_thing
+
+Manages an example thing within the example service.
+`,
+			Options: &FrontMatterOptions{
+				DescriptionSimilarityThreshold: 0.5,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "page title template option matching",
+			Path: "docs/resources/thing.md",
+			Source: `
+page_title: "Example Subcategory: example_thing"
+subcategory: Example Subcategory
+`,
+			Options: &FrontMatterOptions{
+				PageTitleTemplate: "<Subcategory>: <resource_name>",
+				ProviderName:      "example",
+			},
+		},
+		{
+			Name: "page title template option not matching",
+			Path: "docs/resources/thing.md",
+			Source: `
+page_title: "Example Subcategory: Thing"
+subcategory: Example Subcategory
+`,
+			Options: &FrontMatterOptions{
+				PageTitleTemplate: "<Subcategory>: <resource_name>",
+				ProviderName:      "example",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "page title template option missing subcategory",
+			Path: "docs/resources/thing.md",
+			Source: `
+page_title: "example_thing"
 `,
 			Options: &FrontMatterOptions{
-				RequireSubcategory: true,
+				PageTitleTemplate: "<Subcategory>: <resource_name>",
+				ProviderName:      "example",
 			},
 			ExpectError: true,
 		},
@@ -201,7 +473,7 @@ page_title: Example Page Title
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			got := NewFrontMatterCheck(testCase.Options).Run([]byte(testCase.Source))
+			got := NewFrontMatterCheck(testCase.Options).Run(testCase.Path, []byte(testCase.Source))
 
 			if got == nil && testCase.ExpectError {
 				t.Errorf("expected error, got no error")
@@ -0,0 +1,255 @@
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// generatedFilePlaceholder replaces template data that is only known to
+	// tfplugindocs, such as schema descriptions. Rendered placeholders match any text.
+	generatedFilePlaceholder = "tfproviderdocsgeneratedplaceholder"
+)
+
+// generatedFileHeaderRegexp matches the tfplugindocs generated file header,
+// e.g. # generated by https://github.com/hashicorp/terraform-plugin-docs
+var generatedFileHeaderRegexp = regexp.MustCompile(`(?m)^.*generated by (?:tfplugindocs|https://github\.com/hashicorp/terraform-plugin-docs).*\n?`)
+
+// generatedFilePlaceholderRegexp matches placeholders after case changing template functions
+var generatedFilePlaceholderRegexp = regexp.MustCompile(`(?i)` + generatedFilePlaceholder)
+
+type GeneratedFilesCheck struct {
+	Options *GeneratedFilesOptions
+}
+
+// GeneratedFilesOptions represents configuration options for GeneratedFiles.
+type GeneratedFilesOptions struct {
+	*FileOptions
+
+	// ChangedFiles contains the changed file paths, relative to the base path,
+	// e.g. from git diff --name-only. If not empty, changed generated files are
+	// reported when their template and example files are unchanged.
+	ChangedFiles []string
+
+	Enable       bool
+	ProviderName string
+}
+
+func NewGeneratedFilesCheck(opts *GeneratedFilesOptions) *GeneratedFilesCheck {
+	check := &GeneratedFilesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &GeneratedFilesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies that Terraform Registry documentation files with the
+// tfplugindocs generated file header can be generated from their templates
+// and examples, to catch edits that will be removed by the next generation.
+//
+// Files generated with the tfplugindocs default templates cannot be verified
+// against their sources, so are only checked against the changed files.
+func (check *GeneratedFilesCheck) Run(directories map[string][]string) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for _, directory := range sortedDirectories(directories) {
+		var subdirectory string
+
+		switch directory {
+		case RegistryIndexDirectory:
+		case RegistryIndexDirectory + "/" + RegistryDataSourcesDirectory, RegistryIndexDirectory + "/" + RegistryFunctionsDirectory, RegistryIndexDirectory + "/" + RegistryGuidesDirectory, RegistryIndexDirectory + "/" + RegistryResourcesDirectory:
+			subdirectory = filepath.Base(directory)
+		default:
+			continue
+		}
+
+		for _, file := range directories[directory] {
+			if err := check.RunFile(subdirectory, file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies a single documentation file in the Terraform Registry
+// documentation subdirectory (e.g. resources or empty for the index).
+func (check *GeneratedFilesCheck) RunFile(subdirectory string, path string) error {
+	fullpath := check.Options.FullPath(path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	if !generatedFileHeaderRegexp.Match(content) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Checking generated file: %s", fullpath)
+
+	name := TrimFileExtension(path)
+	template, ok := check.templateFile(subdirectory, name)
+
+	if !ok {
+		return check.changedFileError(subdirectory, name, path)
+	}
+
+	templateContent, err := check.Options.ReadFile(template)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading template file (%s): %w", path, template, err)
+	}
+
+	// Static files are copied without rendering
+	if !strings.HasSuffix(template, FileExtensionMdTmpl) {
+		if !bytes.Equal(generatedFileNormalize(templateContent), generatedFileNormalize(content)) {
+			return fmt.Errorf("%s: generated file does not match source file (%s), edit the source file and regenerate documentation instead", path, template)
+		}
+
+		return nil
+	}
+
+	data := newTemplateData(check.Options.FileOptions, check.Options.ProviderName, subdirectory, name)
+
+	if data.Name != "" {
+		data.Description = generatedFilePlaceholder
+	}
+
+	data.SchemaMarkdown = generatedFilePlaceholder
+
+	rendered, err := renderTemplate(check.Options.FileOptions, template, templateContent, data)
+
+	if err != nil {
+		return fmt.Errorf("%s: error rendering template file (%s): %w", path, template, err)
+	}
+
+	if !generatedFileMatches(rendered, content) {
+		return fmt.Errorf("%s: generated file does not match template file (%s) and examples, edit the template or example files and regenerate documentation instead", path, template)
+	}
+
+	return nil
+}
+
+// changedFileError returns an error if the generated file is changed while
+// its template and example files are unchanged.
+func (check *GeneratedFilesCheck) changedFileError(subdirectory string, name string, path string) error {
+	if len(check.Options.ChangedFiles) == 0 || !slices.Contains(check.Options.ChangedFiles, filepath.ToSlash(path)) {
+		return nil
+	}
+
+	for _, source := range check.sourcePaths(subdirectory, name) {
+		for _, changedFile := range check.Options.ChangedFiles {
+			if changedFile == source || strings.HasPrefix(changedFile, source+"/") {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%s: generated file changed without changes to its template or example files, edit those files and regenerate documentation instead", path)
+}
+
+// templateFile returns the first existing template file path for the
+// generated file: a named template, a static file, or a subdirectory template.
+func (check *GeneratedFilesCheck) templateFile(subdirectory string, name string) (string, bool) {
+	var candidates []string
+
+	if subdirectory == "" {
+		candidates = []string{
+			TemplatesDirectory + "/" + name + FileExtensionMdTmpl,
+			TemplatesDirectory + "/" + name + FileExtensionMd,
+		}
+	} else {
+		candidates = []string{
+			TemplatesDirectory + "/" + subdirectory + "/" + name + FileExtensionMdTmpl,
+			TemplatesDirectory + "/" + subdirectory + "/" + name + FileExtensionMd,
+		}
+
+		if subdirectory != RegistryGuidesDirectory {
+			candidates = append(candidates, TemplatesDirectory+"/"+subdirectory+FileExtensionMdTmpl)
+		}
+	}
+
+	for _, candidate := range candidates {
+		if check.Options.fileExists(candidate) {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+// sourcePaths returns the template and example file and directory paths used
+// to generate the documentation file.
+func (check *GeneratedFilesCheck) sourcePaths(subdirectory string, name string) []string {
+	var result []string
+
+	if subdirectory == "" {
+		result = []string{
+			TemplatesDirectory + "/" + name + FileExtensionMdTmpl,
+			TemplatesDirectory + "/" + name + FileExtensionMd,
+		}
+	} else {
+		result = []string{
+			TemplatesDirectory + "/" + subdirectory + "/" + name + FileExtensionMdTmpl,
+			TemplatesDirectory + "/" + subdirectory + "/" + name + FileExtensionMd,
+			TemplatesDirectory + "/" + subdirectory + FileExtensionMdTmpl,
+		}
+	}
+
+	data := newTemplateData(check.Options.FileOptions, check.Options.ProviderName, subdirectory, name)
+
+	if data.ExampleFile != "" {
+		result = append(result, filepath.ToSlash(filepath.Dir(data.ExampleFile)))
+	}
+
+	return result
+}
+
+// generatedFileMatches returns true if the generated file content matches the
+// rendered template, where placeholders match any text.
+func generatedFileMatches(rendered []byte, content []byte) bool {
+	parts := generatedFilePlaceholderRegexp.Split(string(generatedFileNormalize(rendered)), -1)
+
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	pattern, err := regexp.Compile(`^` + strings.Join(parts, `(?s:.*)`) + `$`)
+
+	if err != nil {
+		return false
+	}
+
+	return pattern.Match(generatedFileNormalize(content))
+}
+
+// generatedFileNormalize removes the tfplugindocs generated file header,
+// carriage returns, and surrounding whitespace.
+func generatedFileNormalize(content []byte) []byte {
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	content = generatedFileHeaderRegexp.ReplaceAll(content, nil)
+
+	return bytes.TrimSpace(content)
+}
@@ -0,0 +1,140 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestGeneratedFilesCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Options      *GeneratedFilesOptions
+		ExpectErrors int
+	}{
+		{
+			Name:    "disabled",
+			Options: &GeneratedFilesOptions{},
+		},
+		{
+			Name: "enabled",
+			Options: &GeneratedFilesOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectErrors: 2,
+		},
+		{
+			Name: "changed default template file",
+			Options: &GeneratedFilesOptions{
+				ChangedFiles: []string{"docs/resources/default.md"},
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectErrors: 3,
+		},
+		{
+			Name: "changed default template file and example",
+			Options: &GeneratedFilesOptions{
+				ChangedFiles: []string{"docs/resources/default.md", "examples/resources/test_default/resource.tf"},
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectErrors: 2,
+		},
+		{
+			Name: "changed default template file and subdirectory template",
+			Options: &GeneratedFilesOptions{
+				ChangedFiles: []string{"docs/resources/default.md", "templates/resources.md.tmpl"},
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectErrors: 2,
+		},
+		{
+			Name: "changed verified file",
+			Options: &GeneratedFilesOptions{
+				ChangedFiles: []string{"docs/resources/thing.md"},
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectErrors: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			basePath := "testdata/generated-files"
+			directories, err := GetDirectories(basePath)
+
+			if err != nil {
+				t.Fatalf("error getting directories: %s", err)
+			}
+
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: basePath,
+			}
+
+			got := NewGeneratedFilesCheck(testCase.Options).Run(directories)
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
+
+func TestGeneratedFileMatches(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Rendered string
+		Content  string
+		Expect   bool
+	}{
+		{
+			Name:     "exact",
+			Rendered: "# Title\n\nText.\n",
+			Content:  "# Title\n\nText.\n",
+			Expect:   true,
+		},
+		{
+			Name:     "header and whitespace",
+			Rendered: "---\npage_title: \"Title\"\n---\n\n# Title\n",
+			Content:  "---\n# generated by https://github.com/hashicorp/terraform-plugin-docs\npage_title: \"Title\"\n---\n\n# Title\n\n\n",
+			Expect:   true,
+		},
+		{
+			Name:     "placeholder",
+			Rendered: "# Title\n\n" + generatedFilePlaceholder + "\n\n## Example\n",
+			Content:  "# Title\n\nAny *description*\nover lines.\n\n## Example\n",
+			Expect:   true,
+		},
+		{
+			Name:     "title placeholder",
+			Rendered: "# Title\n\n  T" + generatedFilePlaceholder[1:] + "\n",
+			Content:  "# Title\n\n  Description.\n",
+			Expect:   true,
+		},
+		{
+			Name:     "edited",
+			Rendered: "# Title\n\n" + generatedFilePlaceholder + "\n\n## Example\n",
+			Content:  "# Title\n\nDescription.\n\n## Example Usage\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := generatedFileMatches([]byte(testCase.Rendered), []byte(testCase.Content))
+
+			if got != testCase.Expect {
+				t.Errorf("expected %t, got %t", testCase.Expect, got)
+			}
+		})
+	}
+}
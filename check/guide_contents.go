@@ -0,0 +1,203 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+	"gopkg.in/yaml.v2"
+)
+
+// guideCodeBlockLanguages are the default allowed code block languages in guides
+var guideCodeBlockLanguages = []string{
+	"bash",
+	"console",
+	"hcl",
+	"json",
+	"powershell",
+	"shell",
+	"terraform",
+	"text",
+	"yaml",
+}
+
+type GuideContentsCheck struct {
+	Options *GuideContentsOptions
+}
+
+// GuideContentsOptions represents configuration options for GuideContents.
+type GuideContentsOptions struct {
+	// AllowedCodeBlockLanguages overrides the default allowed code block languages
+	AllowedCodeBlockLanguages []string
+
+	Enable bool
+
+	// ExampleResourceTypes enables verifying provider data source and resource
+	// types in code blocks are known
+	ExampleResourceTypes *ExampleResourceTypesOptions
+
+	// RequiredSections contains required heading text by guide file name pattern,
+	// e.g. version-*-upgrade.md
+	RequiredSections map[string][]string
+
+	// SkipHclSyntax skips verifying terraform and hcl code blocks can be parsed
+	// as HCL, e.g. when the standalone HCL syntax check is enabled
+	SkipHclSyntax bool
+}
+
+func NewGuideContentsCheck(opts *GuideContentsOptions) *GuideContentsCheck {
+	check := &GuideContentsCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &GuideContentsOptions{}
+	}
+
+	if len(check.Options.AllowedCodeBlockLanguages) == 0 {
+		check.Options.AllowedCodeBlockLanguages = guideCodeBlockLanguages
+	}
+
+	return check
+}
+
+// Run verifies the contents of a guide Markdown source.
+//
+// Guides must have a single level 1 heading matching the frontmatter
+// page_title, code blocks with allowed languages, terraform and hcl code
+// blocks that can be parsed as HCL and only reference known provider data
+// source and resource types (if configured), and all required sections for the
+// file name.
+func (check *GuideContentsCheck) Run(filePath string, src []byte) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	document, _ := markdown.Parse(src)
+
+	if err := check.titleError(document, src); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		fencedCodeBlock, ok := node.(*ast.FencedCodeBlock)
+
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		language := markdown.FencedCodeBlockLanguage(fencedCodeBlock, src)
+
+		if !slices.Contains(check.Options.AllowedCodeBlockLanguages, language) {
+			result = multierror.Append(result, fmt.Errorf("line %d: code block language (%s) should be one of: %s", markdown.FencedCodeBlockLineNumber(fencedCodeBlock, src), language, strings.Join(check.Options.AllowedCodeBlockLanguages, ", ")))
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := NewHclSyntaxCheck(&HclSyntaxOptions{Enable: !check.Options.SkipHclSyntax}).Run(src); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := NewExampleResourceTypesCheck(check.Options.ExampleResourceTypes).Run(src); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	for _, section := range check.requiredSections(filePath) {
+		if !hasHeadingText(document, src, section) {
+			result = multierror.Append(result, fmt.Errorf("missing required section heading: %s", section))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// guideContentsOptions returns a copy of the guide contents options without
+// the checks of enabled standalone file checks, which would otherwise report
+// every HCL syntax and example resource type error twice.
+func guideContentsOptions(opts *GuideContentsOptions, hclSyntax *HclSyntaxOptions, exampleResourceTypes *ExampleResourceTypesOptions) *GuideContentsOptions {
+	result := *opts
+
+	if hclSyntax != nil && hclSyntax.Enable {
+		result.SkipHclSyntax = true
+	}
+
+	if exampleResourceTypes != nil && exampleResourceTypes.Enable {
+		result.ExampleResourceTypes = nil
+	}
+
+	return &result
+}
+
+func (check *GuideContentsCheck) titleError(document ast.Node, src []byte) error {
+	var titles []*ast.Heading
+
+	for _, heading := range markdown.Headings(document) {
+		if heading.Level == 1 {
+			titles = append(titles, heading)
+		}
+	}
+
+	if len(titles) != 1 {
+		return fmt.Errorf("guide should have exactly one level 1 heading, found: %d", len(titles))
+	}
+
+	frontMatter := FrontMatterData{}
+
+	if err := yaml.Unmarshal(src, &frontMatter); err != nil || frontMatter.PageTitle == nil {
+		return nil
+	}
+
+	if title := string(titles[0].Text(src)); title != *frontMatter.PageTitle {
+		return fmt.Errorf("line %d: level 1 heading (%s) should match page_title: %s", markdown.NodeLineNumber(titles[0], src), title, *frontMatter.PageTitle)
+	}
+
+	return nil
+}
+
+// requiredSections returns the sorted required section headings for all
+// matching file name patterns.
+func (check *GuideContentsCheck) requiredSections(filePath string) []string {
+	var result []string
+
+	for pattern, sections := range check.Options.RequiredSections {
+		if matched, _ := filepath.Match(pattern, filepath.Base(filePath)); !matched {
+			continue
+		}
+
+		for _, section := range sections {
+			if !slices.Contains(result, section) {
+				result = append(result, section)
+			}
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func hasHeadingText(document ast.Node, src []byte, text string) bool {
+	for _, heading := range markdown.Headings(document) {
+		if string(heading.Text(src)) == text {
+			return true
+		}
+	}
+
+	return false
+}
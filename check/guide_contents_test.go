@@ -0,0 +1,228 @@
+package check
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGuideContentsCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Source      string
+		Options     *GuideContentsOptions
+		ExpectError string
+	}{
+		{
+			Name: "disabled",
+			Path: "docs/guides/example.md",
+			Source: "## Example\n" +
+				"\n" +
+				"```\n" +
+				"example\n" +
+				"```\n",
+		},
+		{
+			Name: "valid",
+			Path: "docs/guides/example.md",
+			Source: "---\n" +
+				"page_title: \"Example Guide\"\n" +
+				"---\n" +
+				"\n" +
+				"# Example Guide\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+			},
+		},
+		{
+			Name: "multiple level 1 headings",
+			Path: "docs/guides/example.md",
+			Source: "# Example Guide\n" +
+				"\n" +
+				"# Another Example Guide\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+			},
+			ExpectError: "exactly one level 1 heading, found: 2",
+		},
+		{
+			Name: "level 1 heading not matching page_title",
+			Path: "docs/guides/example.md",
+			Source: "---\n" +
+				"page_title: \"Example Guide\"\n" +
+				"---\n" +
+				"\n" +
+				"# Another Example Guide\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+			},
+			ExpectError: "line 5: level 1 heading (Another Example Guide) should match page_title: Example Guide",
+		},
+		{
+			Name: "missing code block language",
+			Path: "docs/guides/example.md",
+			Source: "# Example Guide\n" +
+				"\n" +
+				"```\n" +
+				"example\n" +
+				"```\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+			},
+			ExpectError: "line 4: code block language (MISSING)",
+		},
+		{
+			Name: "allowed code block languages option",
+			Path: "docs/guides/example.md",
+			Source: "# Example Guide\n" +
+				"\n" +
+				"```go\n" +
+				"package example\n" +
+				"```\n",
+			Options: &GuideContentsOptions{
+				AllowedCodeBlockLanguages: []string{"go"},
+				Enable:                    true,
+			},
+		},
+		{
+			Name: "invalid HCL syntax",
+			Path: "docs/guides/example.md",
+			Source: "# Example Guide\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"```\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+			},
+			ExpectError: "line 4: ",
+		},
+		{
+			Name: "invalid HCL syntax skipped",
+			Path: "docs/guides/example.md",
+			Source: "# Example Guide\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"```\n",
+			Options: &GuideContentsOptions{
+				Enable:        true,
+				SkipHclSyntax: true,
+			},
+		},
+		{
+			Name: "unknown resource type",
+			Path: "docs/guides/example.md",
+			Source: "# Example Guide\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_unknown\" \"example\" {}\n" +
+				"```\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+				ExampleResourceTypes: &ExampleResourceTypesOptions{
+					Enable:        true,
+					ProviderName:  "test",
+					ResourceNames: []string{"test_thing"},
+				},
+			},
+			ExpectError: "resource type (test_unknown) not found in provider schema",
+		},
+		{
+			Name: "required sections matching",
+			Path: "docs/guides/version-2-upgrade.md",
+			Source: "# Example Upgrade Guide\n" +
+				"\n" +
+				"## Provider Version Configuration\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+				RequiredSections: map[string][]string{
+					"version-*-upgrade.md": {"Provider Version Configuration"},
+				},
+			},
+		},
+		{
+			Name:   "required sections not matching file name",
+			Path:   "docs/guides/example.md",
+			Source: "# Example Guide\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+				RequiredSections: map[string][]string{
+					"version-*-upgrade.md": {"Provider Version Configuration"},
+				},
+			},
+		},
+		{
+			Name:   "required sections missing",
+			Path:   "docs/guides/version-2-upgrade.md",
+			Source: "# Example Upgrade Guide\n",
+			Options: &GuideContentsOptions{
+				Enable: true,
+				RequiredSections: map[string][]string{
+					"version-*-upgrade.md": {"Provider Version Configuration"},
+				},
+			},
+			ExpectError: "missing required section heading: Provider Version Configuration",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewGuideContentsCheck(testCase.Options).Run(testCase.Path, []byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError != "" {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && testCase.ExpectError == "" {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got != nil && !strings.Contains(got.Error(), testCase.ExpectError) {
+				t.Errorf("expected error containing %q, got error: %s", testCase.ExpectError, got)
+			}
+		})
+	}
+}
+
+func TestGuideContentsOptions(t *testing.T) {
+	exampleResourceTypes := &ExampleResourceTypesOptions{
+		Enable:        true,
+		ProviderName:  "test",
+		ResourceNames: []string{"test_thing"},
+	}
+	opts := &GuideContentsOptions{
+		Enable:               true,
+		ExampleResourceTypes: exampleResourceTypes,
+	}
+
+	got := guideContentsOptions(opts, &HclSyntaxOptions{Enable: true}, &ExampleResourceTypesOptions{Enable: true})
+
+	if !got.SkipHclSyntax {
+		t.Errorf("expected SkipHclSyntax with standalone HCL syntax check enabled")
+	}
+
+	if got.ExampleResourceTypes != nil {
+		t.Errorf("expected no ExampleResourceTypes with standalone example resource types check enabled, got: %#v", got.ExampleResourceTypes)
+	}
+
+	if opts.SkipHclSyntax || opts.ExampleResourceTypes != exampleResourceTypes {
+		t.Errorf("expected original options to be unchanged, got: %#v", opts)
+	}
+
+	got = guideContentsOptions(opts, &HclSyntaxOptions{}, nil)
+
+	if got.SkipHclSyntax {
+		t.Errorf("expected no SkipHclSyntax with standalone HCL syntax check disabled")
+	}
+
+	if got.ExampleResourceTypes != exampleResourceTypes {
+		t.Errorf("expected ExampleResourceTypes with standalone example resource types check disabled, got: %#v", got.ExampleResourceTypes)
+	}
+}
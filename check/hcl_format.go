@@ -0,0 +1,225 @@
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/yuin/goldmark/ast"
+)
+
+type HclFormatCheck struct {
+	Options *HclFormatOptions
+}
+
+// HclFormatOptions represents configuration options for HclFormat.
+type HclFormatOptions struct {
+	*FileOptions
+
+	Enable bool
+
+	// Fix rewrites code blocks in canonical format instead of returning errors
+	Fix bool
+}
+
+func NewHclFormatCheck(opts *HclFormatOptions) *HclFormatCheck {
+	check := &HclFormatCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &HclFormatOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies that all terraform code blocks in the Markdown source are in canonical format.
+//
+// If the Fix option is enabled, the file at path is instead rewritten with
+// only the contents of non-canonical code blocks replaced. Code blocks that
+// cannot be rewritten (e.g. with tab indentation) are still returned as errors.
+// Files in a file system (FS) cannot be rewritten.
+func (check *HclFormatCheck) Run(path string, src []byte) error {
+	if !check.Options.Enable && !check.Options.Fix {
+		return nil
+	}
+
+	formatted, lines, unfixedLines := hclFormatSource(src)
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	if check.Options.Fix {
+		if check.Options.FS != nil {
+			return fmt.Errorf("cannot fix code block format in file system")
+		}
+
+		fullpath := check.Options.FullPath(path)
+
+		if !bytes.Equal(formatted, src) {
+			log.Printf("[INFO] Formatting %d code block(s) in file: %s", len(lines)-len(unfixedLines), fullpath)
+
+			fi, err := os.Stat(fullpath)
+
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(fullpath, formatted, fi.Mode().Perm()); err != nil {
+				return fmt.Errorf("error writing formatted file: %w", err)
+			}
+		}
+
+		var result *multierror.Error
+
+		for _, line := range unfixedLines {
+			result = multierror.Append(result, fmt.Errorf("line %d: code block is not in canonical format (terraform fmt) and cannot be fixed automatically", line))
+		}
+
+		return result.ErrorOrNil()
+	}
+
+	var result *multierror.Error
+
+	for _, line := range lines {
+		result = multierror.Append(result, fmt.Errorf("line %d: code block is not in canonical format (terraform fmt)", line))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// hclFormatReplacement represents formatted text for a range of Markdown source.
+type hclFormatReplacement struct {
+	Start int
+	Stop  int
+	Text  []byte
+}
+
+// hclFormatSource returns the Markdown source with terraform code blocks in
+// canonical format, the line numbers of code blocks that were not, and the
+// line numbers of those code blocks that could not be replaced.
+//
+// Code blocks with HCL syntax errors are skipped. Only the code block
+// contents are replaced, so the surrounding Markdown is unchanged.
+func hclFormatSource(src []byte) ([]byte, []int, []int) {
+	document, _ := markdown.Parse(src)
+
+	var lines []int
+	var replacements []hclFormatReplacement
+	var unfixedLines []int
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		fencedCodeBlock, ok := node.(*ast.FencedCodeBlock)
+
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		if markdown.FencedCodeBlockLanguage(fencedCodeBlock, src) != markdown.FencedCodeBlockLanguageTerraform {
+			return ast.WalkSkipChildren, nil
+		}
+
+		text := []byte(markdown.FencedCodeBlockRawText(fencedCodeBlock, src))
+
+		if _, diags := hclwrite.ParseConfig(text, "", hcl.InitialPos); diags.HasErrors() {
+			return ast.WalkSkipChildren, nil
+		}
+
+		formattedText := hclwrite.Format(text)
+
+		if bytes.Equal(text, formattedText) {
+			return ast.WalkSkipChildren, nil
+		}
+
+		line := markdown.FencedCodeBlockLineNumber(fencedCodeBlock, src)
+		lines = append(lines, line)
+
+		replacement, ok := hclFormatCodeBlockReplacement(fencedCodeBlock, src, formattedText)
+
+		if !ok {
+			unfixedLines = append(unfixedLines, line)
+			return ast.WalkSkipChildren, nil
+		}
+
+		replacements = append(replacements, replacement)
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if len(replacements) == 0 {
+		return src, lines, unfixedLines
+	}
+
+	sort.Slice(replacements, func(i, j int) bool { return replacements[i].Start < replacements[j].Start })
+
+	var result bytes.Buffer
+	var position int
+
+	for _, replacement := range replacements {
+		result.Write(src[position:replacement.Start])
+		result.Write(replacement.Text)
+		position = replacement.Stop
+	}
+
+	result.Write(src[position:])
+
+	return result.Bytes(), lines, unfixedLines
+}
+
+// hclFormatCodeBlockReplacement returns the replacement for a code block
+// using its line segment positions, re-applying any indentation (e.g. code
+// blocks within lists). Code blocks with tab padding are not replaced.
+func hclFormatCodeBlockReplacement(fcb *ast.FencedCodeBlock, src []byte, formattedText []byte) (hclFormatReplacement, bool) {
+	segments := fcb.Lines()
+
+	if segments.Len() == 0 {
+		return hclFormatReplacement{}, false
+	}
+
+	for i := 0; i < segments.Len(); i++ {
+		if segments.At(i).Padding != 0 {
+			return hclFormatReplacement{}, false
+		}
+	}
+
+	first := segments.At(0)
+	last := segments.At(segments.Len() - 1)
+	lineStart := bytes.LastIndexByte(src[:first.Start], '\n') + 1
+	indent := src[lineStart:first.Start]
+
+	if len(bytes.Trim(indent, " \t")) != 0 {
+		return hclFormatReplacement{}, false
+	}
+
+	var text bytes.Buffer
+
+	for i, line := range bytes.SplitAfter(formattedText, []byte("\n")) {
+		if i > 0 && len(bytes.TrimSpace(line)) > 0 {
+			text.Write(indent)
+		}
+
+		text.Write(line)
+	}
+
+	return hclFormatReplacement{
+		Start: first.Start,
+		Stop:  last.Stop,
+		Text:  text.Bytes(),
+	}, true
+}
@@ -0,0 +1,317 @@
+package check
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestHclFormatCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Source      string
+		Options     *HclFormatOptions
+		ExpectError bool
+	}{
+		{
+			Name: "disabled",
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"  description = \"example\"\n" +
+				"}\n" +
+				"```\n",
+		},
+		{
+			Name: "canonical format",
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name        = \"example\"\n" +
+				"  description = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Options: &HclFormatOptions{
+				Enable: true,
+			},
+		},
+		{
+			Name: "misaligned equals",
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"  description = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Options: &HclFormatOptions{
+				Enable: true,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "misaligned equals non-terraform code block",
+			Source: "```hcl\n" +
+				"path \"secret/*\" {\n" +
+				"  capabilities = [\"read\"]\n" +
+				"  description = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Options: &HclFormatOptions{
+				Enable: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewHclFormatCheck(testCase.Options).Run("", []byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestHclFormatCheckFix(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		FS          fs.FS
+		Source      string
+		Expect      string
+		ExpectError bool
+	}{
+		{
+			Name: "fixed",
+			Source: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Expect: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+		},
+		{
+			Name: "unfixed code block in blockquote",
+			Source: "# Example\n" +
+				"\n" +
+				"> ```terraform\n" +
+				"> resource \"test_thing\" \"example\" {\n" +
+				"> name = \"example\"\n" +
+				"> }\n" +
+				"> ```\n",
+			Expect: "# Example\n" +
+				"\n" +
+				"> ```terraform\n" +
+				"> resource \"test_thing\" \"example\" {\n" +
+				"> name = \"example\"\n" +
+				"> }\n" +
+				"> ```\n",
+			ExpectError: true,
+		},
+		{
+			Name: "file system",
+			FS:   fstest.MapFS{},
+			Source: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Expect: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			file, err := os.CreateTemp(os.TempDir(), "TestHclFormatCheckFix")
+
+			if err != nil {
+				t.Fatalf("error creating temporary file: %s", err)
+			}
+
+			defer os.Remove(file.Name())
+
+			if _, err := file.WriteString(testCase.Source); err != nil {
+				t.Fatalf("error writing temporary file: %s", err)
+			}
+
+			file.Close()
+
+			opts := &HclFormatOptions{
+				FileOptions: &FileOptions{
+					BasePath: filepath.Dir(file.Name()),
+					FS:       testCase.FS,
+				},
+				Fix: true,
+			}
+
+			err = NewHclFormatCheck(opts).Run(filepath.Base(file.Name()), []byte(testCase.Source))
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			got, err := os.ReadFile(file.Name())
+
+			if err != nil {
+				t.Fatalf("error reading temporary file: %s", err)
+			}
+
+			if string(got) != testCase.Expect {
+				t.Errorf("expected:\n\n%s\n\ngot:\n\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestHclFormatSource(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Source             string
+		Expect             string
+		ExpectLines        []int
+		ExpectUnfixedLines []int
+	}{
+		{
+			Name: "canonical format",
+			Source: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Expect: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+		},
+		{
+			Name: "multiple code blocks",
+			Source: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"    name = \"example\"\n" +
+				"}\n" +
+				"```\n" +
+				"\n" +
+				"Text  with   *spacing*  preserved.\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example2\" {\n" +
+				"  name = \"example\"\n" +
+				"  description = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Expect: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"}\n" +
+				"```\n" +
+				"\n" +
+				"Text  with   *spacing*  preserved.\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example2\" {\n" +
+				"  name        = \"example\"\n" +
+				"  description = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			ExpectLines: []int{4, 12},
+		},
+		{
+			Name: "code block in list",
+			Source: "* Item\n" +
+				"\n" +
+				"  ```terraform\n" +
+				"  resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"  }\n" +
+				"  ```\n",
+			Expect: "* Item\n" +
+				"\n" +
+				"  ```terraform\n" +
+				"  resource \"test_thing\" \"example\" {\n" +
+				"    name = \"example\"\n" +
+				"  }\n" +
+				"  ```\n",
+			ExpectLines: []int{4},
+		},
+		{
+			Name: "code block in blockquote",
+			Source: "> ```terraform\n" +
+				"> resource \"test_thing\" \"example\" {\n" +
+				"> name = \"example\"\n" +
+				"> }\n" +
+				"> ```\n",
+			Expect: "> ```terraform\n" +
+				"> resource \"test_thing\" \"example\" {\n" +
+				"> name = \"example\"\n" +
+				"> }\n" +
+				"> ```\n",
+			ExpectLines:        []int{2},
+			ExpectUnfixedLines: []int{2},
+		},
+		{
+			Name: "invalid syntax",
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"name = \"example\"\n" +
+				"```\n",
+			Expect: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"name = \"example\"\n" +
+				"```\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, gotLines, gotUnfixedLines := hclFormatSource([]byte(testCase.Source))
+
+			if string(got) != testCase.Expect {
+				t.Errorf("expected:\n\n%s\n\ngot:\n\n%s", testCase.Expect, got)
+			}
+
+			if !reflect.DeepEqual(gotLines, testCase.ExpectLines) {
+				t.Errorf("expected lines %v, got %v", testCase.ExpectLines, gotLines)
+			}
+
+			if !reflect.DeepEqual(gotUnfixedLines, testCase.ExpectUnfixedLines) {
+				t.Errorf("expected unfixed lines %v, got %v", testCase.ExpectUnfixedLines, gotUnfixedLines)
+			}
+		})
+	}
+}
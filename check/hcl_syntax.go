@@ -0,0 +1,116 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/yuin/goldmark/ast"
+)
+
+type HclSyntaxCheck struct {
+	Options *HclSyntaxOptions
+}
+
+// HclSyntaxOptions represents configuration options for HclSyntax.
+type HclSyntaxOptions struct {
+	Enable bool
+}
+
+func NewHclSyntaxCheck(opts *HclSyntaxOptions) *HclSyntaxCheck {
+	check := &HclSyntaxCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &HclSyntaxOptions{}
+	}
+
+	return check
+}
+
+// Run verifies that all terraform and hcl code blocks in the Markdown source can be parsed as HCL.
+//
+// Errors include the line number within the Markdown source.
+func (check *HclSyntaxCheck) Run(src []byte) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	document, _ := markdown.Parse(src)
+
+	var result *multierror.Error
+
+	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		fencedCodeBlock, ok := node.(*ast.FencedCodeBlock)
+
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		language := markdown.FencedCodeBlockLanguage(fencedCodeBlock, src)
+
+		if language != markdown.FencedCodeBlockLanguageHcl && language != markdown.FencedCodeBlockLanguageTerraform {
+			return ast.WalkSkipChildren, nil
+		}
+
+		for _, diag := range hclSyntaxDiagnostics(fencedCodeBlock, src) {
+			result = multierror.Append(result, fmt.Errorf("line %d: %s", diag.Line, diag.Message))
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return result.ErrorOrNil()
+}
+
+// hclSyntaxDiagnostic represents an HCL syntax error within a Markdown source.
+type hclSyntaxDiagnostic struct {
+	// Line is the line number within the Markdown source
+	Line int
+
+	Message string
+}
+
+// hclSyntaxDiagnostics returns HCL syntax errors of a code block.
+func hclSyntaxDiagnostics(fcb *ast.FencedCodeBlock, source []byte) []hclSyntaxDiagnostic {
+	text := markdown.FencedCodeBlockRawText(fcb, source)
+	_, diags := hclsyntax.ParseConfig([]byte(text), "", hcl.InitialPos)
+
+	var result []hclSyntaxDiagnostic
+
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+
+		line := markdown.FencedCodeBlockLineNumber(fcb, source)
+
+		if diag.Subject != nil {
+			line += diag.Subject.Start.Line - 1
+		}
+
+		message := diag.Summary
+
+		if diag.Detail != "" {
+			message = fmt.Sprintf("%s; %s", diag.Summary, diag.Detail)
+		}
+
+		result = append(result, hclSyntaxDiagnostic{
+			Line:    line,
+			Message: message,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,98 @@
+package check
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHclSyntaxCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Source      string
+		Options     *HclSyntaxOptions
+		ExpectError string
+	}{
+		{
+			Name: "disabled",
+			Source: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"```\n",
+		},
+		{
+			Name: "valid terraform code block",
+			Source: "# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Options: &HclSyntaxOptions{
+				Enable: true,
+			},
+		},
+		{
+			Name: "invalid non-HCL code block",
+			Source: "# Example\n" +
+				"\n" +
+				"```console\n" +
+				"$ terraform import test_thing.example {\n" +
+				"```\n",
+			Options: &HclSyntaxOptions{
+				Enable: true,
+			},
+		},
+		{
+			Name: "invalid terraform code block unbalanced braces",
+			Source: "---\n" +
+				"page_title: \"Example\"\n" +
+				"---\n" +
+				"\n" +
+				"# Example\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"```\n",
+			Options: &HclSyntaxOptions{
+				Enable: true,
+			},
+			ExpectError: "line 8: ",
+		},
+		{
+			Name: "invalid hcl code block stray comma",
+			Source: "# Example\n" +
+				"\n" +
+				"```hcl\n" +
+				"\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\",\n" +
+				"}\n" +
+				"```\n",
+			Options: &HclSyntaxOptions{
+				Enable: true,
+			},
+			ExpectError: "line 6: ",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewHclSyntaxCheck(testCase.Options).Run([]byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError != "" {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && testCase.ExpectError == "" {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got != nil && !strings.Contains(got.Error(), testCase.ExpectError) {
+				t.Errorf("expected error containing %q, got error: %s", testCase.ExpectError, got)
+			}
+		})
+	}
+}
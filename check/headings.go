@@ -0,0 +1,85 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+type HeadingsCheck struct {
+	Options *HeadingsOptions
+}
+
+// HeadingsOptions represents configuration options for Headings.
+type HeadingsOptions struct {
+	Enable bool
+}
+
+func NewHeadingsCheck(opts *HeadingsOptions) *HeadingsCheck {
+	check := &HeadingsCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &HeadingsOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the heading hierarchy of the Markdown source.
+//
+// The source must contain exactly one level 1 heading, heading levels must
+// not be skipped (e.g. level 2 to level 4), and heading text must not be
+// duplicated at the same level, which generates colliding anchors.
+func (check *HeadingsCheck) Run(src []byte) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	document, _ := markdown.Parse(src)
+
+	var result *multierror.Error
+	var level1Headings, previousLevel int
+	headingTextLines := make(map[int]map[string]int)
+
+	_ = markdown.WalkHeadings(document, func(heading *ast.Heading) error {
+		line := markdown.NodeLineNumber(heading, src)
+		text := string(heading.Text(src))
+
+		if heading.Level == 1 {
+			level1Headings++
+
+			if level1Headings > 1 {
+				result = multierror.Append(result, fmt.Errorf("line %d: extra level 1 heading (%s), expected exactly one", line, text))
+			}
+		}
+
+		if previousLevel > 0 && heading.Level > previousLevel+1 {
+			result = multierror.Append(result, fmt.Errorf("line %d: heading (%s) level (%d) skips level after previous heading level: %d", line, text, heading.Level, previousLevel))
+		}
+
+		previousLevel = heading.Level
+
+		if headingTextLines[heading.Level] == nil {
+			headingTextLines[heading.Level] = make(map[string]int)
+		}
+
+		if previousLine, ok := headingTextLines[heading.Level][text]; ok {
+			result = multierror.Append(result, fmt.Errorf("line %d: duplicate level %d heading (%s), previously on line: %d", line, heading.Level, text, previousLine))
+			return nil
+		}
+
+		headingTextLines[heading.Level][text] = line
+
+		return nil
+	}, nil)
+
+	if level1Headings == 0 {
+		result = multierror.Append(result, fmt.Errorf("missing level 1 heading"))
+	}
+
+	return result.ErrorOrNil()
+}
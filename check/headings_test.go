@@ -0,0 +1,103 @@
+package check
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHeadingsCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Source      string
+		Options     *HeadingsOptions
+		ExpectError string
+	}{
+		{
+			Name: "disabled",
+			Source: "## Example\n" +
+				"\n" +
+				"#### Example\n",
+		},
+		{
+			Name: "valid",
+			Source: "---\n" +
+				"page_title: \"Example\"\n" +
+				"---\n" +
+				"\n" +
+				"# Example\n" +
+				"\n" +
+				"## Example Usage\n" +
+				"\n" +
+				"### Basic\n" +
+				"\n" +
+				"## Argument Reference\n" +
+				"\n" +
+				"### Nested\n",
+			Options: &HeadingsOptions{
+				Enable: true,
+			},
+		},
+		{
+			Name: "missing level 1 heading",
+			Source: "## Example Usage\n" +
+				"\n" +
+				"Example contents.\n",
+			Options: &HeadingsOptions{
+				Enable: true,
+			},
+			ExpectError: "missing level 1 heading",
+		},
+		{
+			Name: "extra level 1 heading",
+			Source: "# Example\n" +
+				"\n" +
+				"# Another Example\n",
+			Options: &HeadingsOptions{
+				Enable: true,
+			},
+			ExpectError: "line 3: extra level 1 heading",
+		},
+		{
+			Name: "skipped heading level",
+			Source: "# Example\n" +
+				"\n" +
+				"## Example Usage\n" +
+				"\n" +
+				"#### Basic\n",
+			Options: &HeadingsOptions{
+				Enable: true,
+			},
+			ExpectError: "line 5: heading (Basic) level (4) skips level",
+		},
+		{
+			Name: "duplicate heading text",
+			Source: "# Example\n" +
+				"\n" +
+				"## Example Usage\n" +
+				"\n" +
+				"## Example Usage\n",
+			Options: &HeadingsOptions{
+				Enable: true,
+			},
+			ExpectError: "line 5: duplicate level 2 heading (Example Usage), previously on line: 3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewHeadingsCheck(testCase.Options).Run([]byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError != "" {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && testCase.ExpectError == "" {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got != nil && !strings.Contains(got.Error(), testCase.ExpectError) {
+				t.Errorf("expected error containing %q, got error: %s", testCase.ExpectError, got)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package check
 import (
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/hashicorp/go-multierror"
 )
@@ -11,7 +10,11 @@ import (
 type LegacyFunctionFileOptions struct {
 	*FileOptions
 
-	FrontMatter *FrontMatterOptions
+	ExampleResourceTypes *ExampleResourceTypesOptions
+	FrontMatter          *FrontMatterOptions
+	Headings             *HeadingsOptions
+	HclFormat            *HclFormatOptions
+	HclSyntax            *HclSyntaxOptions
 }
 
 type LegacyFunctionFileCheck struct {
@@ -37,10 +40,7 @@ func NewLegacyFunctionFileCheck(opts *LegacyFunctionFileOptions) *LegacyFunction
 		check.Options.FrontMatter = &FrontMatterOptions{}
 	}
 
-	check.Options.FrontMatter.NoSidebarCurrent = true
-	check.Options.FrontMatter.RequireDescription = true
-	check.Options.FrontMatter.RequireLayout = true
-	check.Options.FrontMatter.RequirePageTitle = true
+	check.Options.FrontMatter.Schema = legacyFrontMatterSchema.Merge(check.Options.FrontMatter.Schema)
 
 	return check
 }
@@ -54,20 +54,36 @@ func (check *LegacyFunctionFileCheck) Run(path string) error {
 		return fmt.Errorf("%s: error checking file extension: %w", path, err)
 	}
 
-	if err := FileSizeCheck(fullpath); err != nil {
+	if err := check.Options.fileSizeError(path); err != nil {
 		return fmt.Errorf("%s: error checking file size: %w", path, err)
 	}
 
-	content, err := os.ReadFile(fullpath)
+	content, err := check.Options.ReadFile(path)
 
 	if err != nil {
 		return fmt.Errorf("%s: error reading file: %w", path, err)
 	}
 
-	if err := NewFrontMatterCheck(check.Options.FrontMatter).Run(content); err != nil {
+	if err := NewFrontMatterCheck(check.Options.FrontMatter).Run(path, content); err != nil {
 		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
 	}
 
+	if err := NewHeadingsCheck(check.Options.Headings).Run(content); err != nil {
+		return fmt.Errorf("%s: error checking file headings: %w", path, err)
+	}
+
+	if err := NewHclSyntaxCheck(check.Options.HclSyntax).Run(content); err != nil {
+		return fmt.Errorf("%s: error checking file HCL syntax: %w", path, err)
+	}
+
+	if err := NewHclFormatCheck(check.Options.HclFormat).Run(path, content); err != nil {
+		return fmt.Errorf("%s: error checking file HCL format: %w", path, err)
+	}
+
+	if err := NewExampleResourceTypesCheck(check.Options.ExampleResourceTypes).Run(content); err != nil {
+		return fmt.Errorf("%s: error checking file example resource types: %w", path, err)
+	}
+
 	return nil
 }
 
@@ -3,7 +3,6 @@ package check
 import (
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/hashicorp/go-multierror"
 )
@@ -11,9 +10,13 @@ import (
 type LegacyResourceFileOptions struct {
 	*FileOptions
 
-	Contents     *ContentsOptions
-	FrontMatter  *FrontMatterOptions
-	ProviderName string
+	Contents             *ContentsOptions
+	ExampleResourceTypes *ExampleResourceTypesOptions
+	FrontMatter          *FrontMatterOptions
+	Headings             *HeadingsOptions
+	HclFormat            *HclFormatOptions
+	HclSyntax            *HclSyntaxOptions
+	ProviderName         string
 }
 
 type LegacyResourceFileCheck struct {
@@ -47,10 +50,7 @@ func NewLegacyResourceFileCheck(opts *LegacyResourceFileOptions) *LegacyResource
 		check.Options.FrontMatter = &FrontMatterOptions{}
 	}
 
-	check.Options.FrontMatter.NoSidebarCurrent = true
-	check.Options.FrontMatter.RequireDescription = true
-	check.Options.FrontMatter.RequireLayout = true
-	check.Options.FrontMatter.RequirePageTitle = true
+	check.Options.FrontMatter.Schema = legacyFrontMatterSchema.Merge(check.Options.FrontMatter.Schema)
 
 	return check
 }
@@ -64,21 +64,37 @@ func (check *LegacyResourceFileCheck) Run(path string, exampleLanguage string) e
 		return fmt.Errorf("%s: error checking file extension: %w", path, err)
 	}
 
-	if err := FileSizeCheck(fullpath); err != nil {
+	if err := check.Options.fileSizeError(path); err != nil {
 		return fmt.Errorf("%s: error checking file size: %w", path, err)
 	}
 
-	content, err := os.ReadFile(fullpath)
+	content, err := check.Options.ReadFile(path)
 
 	if err != nil {
 		return fmt.Errorf("%s: error reading file: %w", path, err)
 	}
 
-	if err := NewFrontMatterCheck(check.Options.FrontMatter).Run(content); err != nil {
+	if err := NewFrontMatterCheck(check.Options.FrontMatter).Run(path, content); err != nil {
 		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
 	}
 
-	if err := NewContentsCheck(check.Options.Contents).Run(fullpath, exampleLanguage); err != nil {
+	if err := NewHeadingsCheck(check.Options.Headings).Run(content); err != nil {
+		return fmt.Errorf("%s: error checking file headings: %w", path, err)
+	}
+
+	if err := NewHclSyntaxCheck(check.Options.HclSyntax).Run(content); err != nil {
+		return fmt.Errorf("%s: error checking file HCL syntax: %w", path, err)
+	}
+
+	if err := NewHclFormatCheck(check.Options.HclFormat).Run(path, content); err != nil {
+		return fmt.Errorf("%s: error checking file HCL format: %w", path, err)
+	}
+
+	if err := NewExampleResourceTypesCheck(check.Options.ExampleResourceTypes).Run(content); err != nil {
+		return fmt.Errorf("%s: error checking file example resource types: %w", path, err)
+	}
+
+	if err := NewContentsCheck(check.Options.Contents).Run(fullpath, content, exampleLanguage); err != nil {
 		return fmt.Errorf("%s: error checking file contents: %w", path, err)
 	}
 
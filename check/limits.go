@@ -0,0 +1,205 @@
+package check
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+type LimitsCheck struct {
+	Options *LimitsOptions
+}
+
+// LimitsOptions represents configuration options for Limits.
+type LimitsOptions struct {
+	*FileOptions
+
+	// LargestFiles is the number of largest documentation files to log
+	LargestFiles int
+
+	// MaximumNumberOfFiles is the limit of documentation files, which applies
+	// separately to each CDKTF language, defaulting to RegistryMaximumNumberOfFiles.
+	MaximumNumberOfFiles int
+
+	// WarningThreshold is the percentage (e.g. 90) of the limits at which the
+	// number of documentation files and file sizes are logged as warnings.
+	WarningThreshold float64
+}
+
+func NewLimitsCheck(opts *LimitsOptions) *LimitsCheck {
+	check := &LimitsCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &LimitsOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	if check.Options.MaximumNumberOfFiles == 0 {
+		check.Options.MaximumNumberOfFiles = RegistryMaximumNumberOfFiles
+	}
+
+	return check
+}
+
+// Run verifies that the number of documentation files is below the limit,
+// logging warnings for numbers of files and file sizes above the warning
+// threshold and the largest files, if configured.
+//
+// File sizes above the limit are reported by the file checks.
+func (check *LimitsCheck) Run(directories map[string][]string) error {
+	var result *multierror.Error
+
+	if err := check.numberOfFilesError(directories); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	check.logSizeOfFiles(directories)
+
+	return result.ErrorOrNil()
+}
+
+// numberOfFilesError returns an error if the number of documentation files or
+// CDKTF documentation files of any language reaches the limit.
+func (check *LimitsCheck) numberOfFilesError(directories map[string][]string) error {
+	var hclDirectories []string
+	cdktfDirectories := make(map[string][]string)
+
+	for directory, files := range directories {
+		log.Printf("[TRACE] Found %d documentation files in directory: %s", len(files), directory)
+
+		if IsValidCdktfDirectory(directory) {
+			if cdktfLanguage, ok := cdktfDirectoryLanguage(directory); ok {
+				cdktfDirectories[cdktfLanguage] = append(cdktfDirectories[cdktfLanguage], directory)
+			}
+
+			continue
+		}
+
+		hclDirectories = append(hclDirectories, directory)
+	}
+
+	var result *multierror.Error
+
+	if err := check.directoriesNumberOfFilesError("documentation files", directories, hclDirectories); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	for _, cdktfLanguage := range ValidCdktfLanguages {
+		if _, ok := cdktfDirectories[cdktfLanguage]; !ok {
+			continue
+		}
+
+		if err := check.directoriesNumberOfFilesError(cdktfLanguage+" CDKTF documentation files", directories, cdktfDirectories[cdktfLanguage]); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// directoriesNumberOfFilesError logs the number of files in the directories
+// compared to the limit and returns an error if the limit is reached. Above
+// the warning threshold, the directories are logged by number of files.
+func (check *LimitsCheck) directoriesNumberOfFilesError(description string, directories map[string][]string, limitDirectories []string) error {
+	var numberOfFiles int
+
+	for _, directory := range limitDirectories {
+		numberOfFiles = numberOfFiles + len(directories[directory])
+	}
+
+	limit := check.Options.MaximumNumberOfFiles
+	percentage := float64(numberOfFiles) * 100 / float64(limit)
+
+	log.Printf("[INFO] Found %d %s with limit of %d (%.1f%%)", numberOfFiles, description, limit, percentage)
+
+	if numberOfFiles >= limit {
+		return fmt.Errorf("exceeded maximum (%d) number of %s for Terraform Registry: %d", limit, description, numberOfFiles)
+	}
+
+	if !check.aboveWarningThreshold(percentage) {
+		return nil
+	}
+
+	sort.Slice(limitDirectories, func(i, j int) bool {
+		if len(directories[limitDirectories[i]]) == len(directories[limitDirectories[j]]) {
+			return limitDirectories[i] < limitDirectories[j]
+		}
+
+		return len(directories[limitDirectories[i]]) > len(directories[limitDirectories[j]])
+	})
+
+	directoryNumberOfFiles := make([]string, len(limitDirectories))
+
+	for i, directory := range limitDirectories {
+		directoryNumberOfFiles[i] = fmt.Sprintf("%s (%d)", directory, len(directories[directory]))
+	}
+
+	log.Printf("[WARN] Approaching maximum (%d) number of %s for Terraform Registry: %d (%.1f%%), directories: %s", limit, description, numberOfFiles, percentage, strings.Join(directoryNumberOfFiles, ", "))
+
+	return nil
+}
+
+// logSizeOfFiles logs documentation files with sizes above the warning
+// threshold and the largest documentation files, if configured. Files that
+// cannot be read are logged as warnings, since the file checks report them.
+func (check *LimitsCheck) logSizeOfFiles(directories map[string][]string) {
+	if check.Options.WarningThreshold <= 0 && check.Options.LargestFiles <= 0 {
+		return
+	}
+
+	type fileSize struct {
+		Path string
+		Size int64
+	}
+
+	var fileSizes []fileSize
+
+	for _, directory := range sortedDirectories(directories) {
+		for _, file := range directories[directory] {
+			fi, err := check.Options.Stat(file)
+
+			if err != nil {
+				log.Printf("[WARN] %s: error checking file size: %s", file, err)
+				continue
+			}
+
+			if fi.IsDir() {
+				continue
+			}
+
+			fileSizes = append(fileSizes, fileSize{Path: file, Size: fi.Size()})
+		}
+	}
+
+	limit := check.Options.SizeLimit()
+
+	sort.SliceStable(fileSizes, func(i, j int) bool {
+		return fileSizes[i].Size > fileSizes[j].Size
+	})
+
+	for i, file := range fileSizes {
+		percentage := float64(file.Size) * 100 / float64(limit)
+
+		if i < check.Options.LargestFiles {
+			log.Printf("[INFO] Largest documentation file %d: %s size: %d (%.1f%% of limit: %d)", i+1, file.Path, file.Size, percentage, limit)
+		}
+
+		if file.Size < int64(limit) && check.aboveWarningThreshold(percentage) {
+			log.Printf("[WARN] %s: approaching maximum (%d) size of documentation file for Terraform Registry: %d (%.1f%%)", file.Path, limit, file.Size, percentage)
+		}
+	}
+}
+
+// aboveWarningThreshold returns true if the percentage of a limit reaches the
+// warning threshold, if configured.
+func (check *LimitsCheck) aboveWarningThreshold(percentage float64) bool {
+	return check.Options.WarningThreshold > 0 && percentage >= check.Options.WarningThreshold
+}
@@ -0,0 +1,103 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestLimitsCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Directories  map[string][]string
+		Options      *LimitsOptions
+		ExpectErrors int
+	}{
+		{
+			Name:        "default limit",
+			Directories: testGenerateDirectories(RegistryMaximumNumberOfFiles - 1),
+		},
+		{
+			Name:        "maximum number of files under limit",
+			Directories: testGenerateDirectories(9),
+			Options: &LimitsOptions{
+				MaximumNumberOfFiles: 10,
+			},
+		},
+		{
+			Name: "maximum number of files at limit",
+			Directories: map[string][]string{
+				"docs/resources":                  testGenerateFiles(10),
+				"docs/cdktf/typescript/resources": testGenerateFiles(10),
+			},
+			Options: &LimitsOptions{
+				MaximumNumberOfFiles: 10,
+			},
+			ExpectErrors: 2,
+		},
+		{
+			Name: "maximum number of files over registry limit",
+			Directories: map[string][]string{
+				"docs/resources": testGenerateFiles(RegistryMaximumNumberOfFiles + 1),
+			},
+			Options: &LimitsOptions{
+				MaximumNumberOfFiles: RegistryMaximumNumberOfFiles * 2,
+			},
+		},
+		{
+			Name: "warning threshold number of files",
+			Directories: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md", "docs/resources/widget.md"},
+			},
+			Options: &LimitsOptions{
+				FileOptions: &FileOptions{
+					BasePath: "testdata/limits",
+				},
+				MaximumNumberOfFiles: 3,
+				WarningThreshold:     60,
+			},
+		},
+		{
+			Name: "warning threshold file sizes",
+			Directories: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md", "docs/resources/widget.md"},
+			},
+			Options: &LimitsOptions{
+				FileOptions: &FileOptions{
+					BasePath:          "testdata/limits",
+					MaximumSizeOfFile: 400,
+				},
+				LargestFiles:     1,
+				WarningThreshold: 90,
+			},
+		},
+		{
+			Name: "warning threshold missing file",
+			Directories: map[string][]string{
+				"docs/resources": {"docs/resources/missing.md"},
+			},
+			Options: &LimitsOptions{
+				FileOptions: &FileOptions{
+					BasePath: "testdata/limits",
+				},
+				WarningThreshold: 90,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewLimitsCheck(testCase.Options).Run(testCase.Directories)
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package check
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// linksHtmlAnchorRegexp matches explicit HTML anchors, e.g. <a id="example"></a>
+var linksHtmlAnchorRegexp = regexp.MustCompile(`<a\s+[^>]*\b(?:id|name)\s*=\s*"([^"]+)"`)
+
+// linksLegacySubdirectories maps legacy subdirectories to Terraform Registry subdirectories
+var linksLegacySubdirectories = map[string]string{
+	LegacyDataSourcesDirectory: RegistryDataSourcesDirectory,
+	LegacyFunctionsDirectory:   RegistryFunctionsDirectory,
+	LegacyGuidesDirectory:      RegistryGuidesDirectory,
+	LegacyResourcesDirectory:   RegistryResourcesDirectory,
+}
+
+type LinksCheck struct {
+	Options *LinksOptions
+}
+
+// LinksOptions represents configuration options for Links.
+type LinksOptions struct {
+	*FileOptions
+
+	Enable       bool
+	ProviderName string
+}
+
+// linksPage represents the anchors and links of a documentation file.
+type linksPage struct {
+	Anchors map[string]bool
+	Links   []linksPageLink
+}
+
+// linksPageLink represents a link destination and its line number.
+type linksPageLink struct {
+	Destination string
+	Line        int
+}
+
+func NewLinksCheck(opts *LinksOptions) *LinksCheck {
+	check := &LinksCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &LinksOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies that internal documentation links and anchors resolve to
+// documentation files and headings or explicit HTML anchors.
+//
+// This check presumes that all provided directories are valid. External
+// links and absolute links to other Terraform documentation are skipped.
+func (check *LinksCheck) Run(directories map[string][]string) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	var files []string
+
+	for _, directoryFiles := range directories {
+		files = append(files, directoryFiles...)
+	}
+
+	sort.Strings(files)
+
+	pages := make(map[string]*linksPage, len(files))
+
+	for _, file := range files {
+		content, err := check.Options.ReadFile(file)
+
+		if err != nil {
+			return fmt.Errorf("%s: error reading file: %w", file, err)
+		}
+
+		pages[file] = parseLinksPage(content)
+	}
+
+	var result *multierror.Error
+
+	for _, file := range files {
+		for _, link := range pages[file].Links {
+			if err := check.linkError(file, link, files, pages); err != nil {
+				result = multierror.Append(result, fmt.Errorf("%s: line %d: %w", file, link.Line, err))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (check *LinksCheck) linkError(file string, link linksPageLink, files []string, pages map[string]*linksPage) error {
+	destination, err := url.Parse(link.Destination)
+
+	if err != nil {
+		return fmt.Errorf("error parsing link (%s): %w", link.Destination, err)
+	}
+
+	// External links
+	if destination.Scheme != "" || destination.Host != "" {
+		return nil
+	}
+
+	target := file
+
+	if destination.Path != "" {
+		candidates := check.linkTargetCandidates(file, destination.Path)
+
+		if len(candidates) == 0 {
+			return nil
+		}
+
+		target = linkTargetFile(candidates, files)
+
+		if target == "" {
+			log.Printf("[DEBUG] Link (%s) target candidates: %v", link.Destination, candidates)
+			return fmt.Errorf("link (%s) target not found in documentation files", link.Destination)
+		}
+	}
+
+	if destination.Fragment == "" {
+		return nil
+	}
+
+	if !pages[target].Anchors[strings.ToLower(destination.Fragment)] {
+		return fmt.Errorf("link (%s) anchor (#%s) not found in headings or HTML anchors of: %s", link.Destination, destination.Fragment, target)
+	}
+
+	return nil
+}
+
+// linkTargetCandidates returns the documentation file paths, without file
+// extensions, that a link path may refer to. No candidates are returned for
+// links outside the provider documentation.
+func (check *LinksCheck) linkTargetCandidates(file string, linkPath string) []string {
+	if !strings.HasPrefix(linkPath, "/") {
+		target := path.Join(path.Dir(file), linkPath)
+
+		if !strings.HasPrefix(target, RegistryIndexDirectory+"/") && !strings.HasPrefix(target, LegacyIndexDirectory+"/") {
+			return nil
+		}
+
+		return []string{trimDocumentationExtension(target)}
+	}
+
+	parts := strings.Split(strings.Trim(linkPath, "/"), "/")
+
+	// Legacy website links, e.g. /docs/providers/NAME/r/PAGE.html
+	if len(parts) >= 3 && parts[0] == "docs" && parts[1] == "providers" {
+		if parts[2] != check.Options.ProviderName {
+			return nil
+		}
+
+		return linkPageCandidates(parts[3:])
+	}
+
+	// Terraform Registry links, e.g. /providers/NAMESPACE/NAME/VERSION/docs/resources/PAGE
+	if len(parts) >= 5 && parts[0] == "providers" && parts[4] == "docs" {
+		if parts[2] != check.Options.ProviderName {
+			return nil
+		}
+
+		return linkPageCandidates(parts[5:])
+	}
+
+	return nil
+}
+
+// linkPageCandidates returns the legacy and Terraform Registry documentation
+// file paths, without file extensions, for the page path parts.
+func linkPageCandidates(parts []string) []string {
+	switch len(parts) {
+	case 0:
+		return []string{
+			RegistryIndexDirectory + "/index",
+			LegacyIndexDirectory + "/index",
+		}
+	case 1:
+		page := trimDocumentationExtension(parts[0])
+
+		return []string{
+			RegistryIndexDirectory + "/" + page,
+			LegacyIndexDirectory + "/" + page,
+		}
+	case 2:
+		page := trimDocumentationExtension(parts[1])
+
+		if registrySubdirectory, ok := linksLegacySubdirectories[parts[0]]; ok {
+			return []string{
+				LegacyIndexDirectory + "/" + parts[0] + "/" + page,
+				RegistryIndexDirectory + "/" + registrySubdirectory + "/" + page,
+			}
+		}
+
+		for legacySubdirectory, registrySubdirectory := range linksLegacySubdirectories {
+			if parts[0] == registrySubdirectory {
+				return []string{
+					LegacyIndexDirectory + "/" + legacySubdirectory + "/" + page,
+					RegistryIndexDirectory + "/" + registrySubdirectory + "/" + page,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// linkTargetFile returns the first file matching a candidate or an empty string.
+func linkTargetFile(candidates []string, files []string) string {
+	for _, candidate := range candidates {
+		for _, file := range files {
+			if trimDocumentationExtension(file) == candidate {
+				return file
+			}
+		}
+	}
+
+	return ""
+}
+
+// parseLinksPage returns the anchors and links of Markdown source.
+func parseLinksPage(source []byte) *linksPage {
+	document, _ := markdown.Parse(source)
+	page := &linksPage{
+		Anchors: make(map[string]bool),
+	}
+	headingAnchorCounts := make(map[string]int)
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.Heading:
+			anchor := markdown.HeadingAnchor(string(node.Text(source)))
+
+			// Duplicate headings receive a numeric suffix
+			if count := headingAnchorCounts[anchor]; count > 0 {
+				page.Anchors[fmt.Sprintf("%s-%d", anchor, count)] = true
+			} else {
+				page.Anchors[anchor] = true
+			}
+
+			headingAnchorCounts[anchor]++
+		case *ast.Link:
+			page.Links = append(page.Links, linksPageLink{
+				Destination: string(node.Destination),
+				Line:        markdown.NodeLineNumber(node, source),
+			})
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	for _, match := range linksHtmlAnchorRegexp.FindAllSubmatch(source, -1) {
+		page.Anchors[strings.ToLower(string(match[1]))] = true
+	}
+
+	return page
+}
+
+// trimDocumentationExtension removes known documentation file extensions.
+func trimDocumentationExtension(file string) string {
+	for _, extension := range []string{FileExtensionHtmlMarkdown, FileExtensionHtmlMd, FileExtensionMarkdown, FileExtensionMd, ".html"} {
+		if strings.HasSuffix(file, extension) {
+			return strings.TrimSuffix(file, extension)
+		}
+	}
+
+	return file
+}
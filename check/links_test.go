@@ -0,0 +1,105 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestLinksCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		BasePath     string
+		Options      *LinksOptions
+		ExpectErrors int
+	}{
+		{
+			Name:     "disabled",
+			BasePath: "testdata/invalid-links",
+			Options:  &LinksOptions{},
+		},
+		{
+			Name:     "valid links",
+			BasePath: "testdata/valid-links",
+			Options: &LinksOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+		},
+		{
+			Name:     "invalid links",
+			BasePath: "testdata/invalid-links",
+			Options: &LinksOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectErrors: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			directories, err := GetDirectories(testCase.BasePath)
+
+			if err != nil {
+				t.Fatalf("error getting directories: %s", err)
+			}
+
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: testCase.BasePath,
+			}
+
+			got := NewLinksCheck(testCase.Options).Run(directories)
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
+
+func TestTrimDocumentationExtension(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		File   string
+		Expect string
+	}{
+		{
+			Name:   "legacy file",
+			File:   "website/docs/r/thing.html.markdown",
+			Expect: "website/docs/r/thing",
+		},
+		{
+			Name:   "registry file",
+			File:   "docs/guides/2.0-upgrade.md",
+			Expect: "docs/guides/2.0-upgrade",
+		},
+		{
+			Name:   "legacy website link",
+			File:   "thing.html",
+			Expect: "thing",
+		},
+		{
+			Name:   "without extension",
+			File:   "docs/resources/thing",
+			Expect: "docs/resources/thing",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := trimDocumentationExtension(testCase.File)
+			want := testCase.Expect
+
+			if got != want {
+				t.Errorf("expected %s, got %s", want, got)
+			}
+		})
+	}
+}
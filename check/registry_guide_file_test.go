@@ -46,6 +46,38 @@ func TestRegistryGuideFileCheck(t *testing.T) {
 			Path:        "guide_with_sidebar_current.md",
 			ExpectError: true,
 		},
+		{
+			Name:     "invalid HCL syntax disabled",
+			BasePath: "testdata/invalid-registry-files",
+			Path:     "guide_invalid_hcl_syntax.md",
+		},
+		{
+			Name:     "invalid HCL syntax",
+			BasePath: "testdata/invalid-registry-files",
+			Path:     "guide_invalid_hcl_syntax.md",
+			Options: &RegistryGuideFileOptions{
+				HclSyntax: &HclSyntaxOptions{
+					Enable: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:     "invalid headings disabled",
+			BasePath: "testdata/invalid-registry-files",
+			Path:     "guide_invalid_headings.md",
+		},
+		{
+			Name:     "invalid headings",
+			BasePath: "testdata/invalid-registry-files",
+			Path:     "guide_invalid_headings.md",
+			Options: &RegistryGuideFileOptions{
+				Headings: &HeadingsOptions{
+					Enable: true,
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
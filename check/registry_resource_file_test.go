@@ -40,6 +40,13 @@ func TestRegistryResourceFileCheck(t *testing.T) {
 			ExampleLanguage: "terraform",
 			ExpectError:     true,
 		},
+		{
+			Name:            "invalid frontmatter with unknown key",
+			BasePath:        "testdata/invalid-registry-files",
+			Path:            "resource_with_unknown_frontmatter_key.md",
+			ExampleLanguage: "terraform",
+			ExpectError:     true,
+		},
 		{
 			Name:            "invalid frontmatter with sidebar_current",
 			BasePath:        "testdata/invalid-registry-files",
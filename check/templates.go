@@ -0,0 +1,443 @@
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+const (
+	FileExtensionMdTmpl = `.md.tmpl`
+
+	// TemplatesGlobPattern matches tfplugindocs template files
+	TemplatesGlobPattern = `templates/**/*.md.tmpl`
+
+	TemplatesDirectory = `templates`
+
+	// ExamplesDirectory is the tfplugindocs examples directory
+	ExamplesDirectory = `examples`
+)
+
+type TemplatesCheck struct {
+	Options *TemplatesOptions
+}
+
+// TemplatesOptions represents configuration options for Templates.
+type TemplatesOptions struct {
+	*FileOptions
+
+	DataSourceFileMismatch *FileMismatchOptions
+	DataSourceFrontMatter  *FrontMatterOptions
+	Enable                 bool
+	FunctionFileMismatch   *FileMismatchOptions
+	FunctionFrontMatter    *FrontMatterOptions
+	GuideFrontMatter       *FrontMatterOptions
+	IndexFrontMatter       *FrontMatterOptions
+	ProviderName           string
+	ResourceFileMismatch   *FileMismatchOptions
+	ResourceFrontMatter    *FrontMatterOptions
+}
+
+// TemplateData represents the data available to tfplugindocs templates.
+//
+// Not all fields are available to all template types in tfplugindocs, e.g.
+// Name is not available in index templates.
+type TemplateData struct {
+	Description string
+	ExampleFile string
+	HasExample  bool
+	HasImport   bool
+
+	// HasImportIDConfig and HasImportIdentityConfig are true if import block examples exist
+	HasImportIDConfig        bool
+	HasImportIdentityConfig  bool
+	ImportFile               string
+	ImportIDConfigFile       string
+	ImportIdentityConfigFile string
+	Name                     string
+	ProviderName             string
+	ProviderShortName        string
+	RenderedProviderName     string
+	SchemaMarkdown           string
+	Type                     string
+}
+
+func NewTemplatesCheck(opts *TemplatesOptions) *TemplatesCheck {
+	check := &TemplatesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &TemplatesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	check.Options.DataSourceFrontMatter = templateFrontMatterOptions(check.Options.DataSourceFrontMatter, registryFrontMatterSchema)
+	check.Options.FunctionFrontMatter = templateFrontMatterOptions(check.Options.FunctionFrontMatter, registryFrontMatterSchema)
+	check.Options.GuideFrontMatter = templateFrontMatterOptions(check.Options.GuideFrontMatter, registryGuideFrontMatterSchema)
+	check.Options.IndexFrontMatter = templateFrontMatterOptions(check.Options.IndexFrontMatter, registryIndexFrontMatterSchema)
+	check.Options.ResourceFrontMatter = templateFrontMatterOptions(check.Options.ResourceFrontMatter, registryFrontMatterSchema)
+
+	return check
+}
+
+// Run verifies that all tfplugindocs template files parse as Go templates,
+// are named after known data sources, functions, and resources, and render
+// frontmatter matching the Terraform Registry file expectations.
+func (check *TemplatesCheck) Run() error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	directories, err := GetTemplateDirectoriesFS(check.Options.FS, check.Options.BasePath)
+
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+
+	for _, directory := range sortedDirectories(directories) {
+		for _, file := range directories[directory] {
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies a single template file.
+func (check *TemplatesCheck) RunFile(path string) error {
+	fullpath := check.Options.FullPath(path)
+
+	log.Printf("[DEBUG] Checking template file: %s", fullpath)
+
+	if err := check.fileMismatchError(path); err != nil {
+		return fmt.Errorf("%s: error checking template file name: %w", path, err)
+	}
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	rendered, err := renderTemplate(check.Options.FileOptions, path, content, check.templateData(path))
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	frontMatterOpts := check.frontMatterOptions(path)
+
+	if frontMatterOpts == nil {
+		return nil
+	}
+
+	if err := NewFrontMatterCheck(frontMatterOpts).Run(path, rendered); err != nil {
+		return fmt.Errorf("%s: error checking template frontmatter: %w", path, err)
+	}
+
+	return nil
+}
+
+// fileMismatchError returns an error if a data source, function, or resource
+// template is not named after a known data source, function, or resource.
+//
+// Missing templates are not reported, as tfplugindocs generates those files
+// with a default template.
+func (check *TemplatesCheck) fileMismatchError(path string) error {
+	var opts *FileMismatchOptions
+
+	switch filepath.Dir(path) {
+	case TemplatesDirectory + "/" + RegistryDataSourcesDirectory:
+		opts = check.Options.DataSourceFileMismatch
+	case TemplatesDirectory + "/" + RegistryFunctionsDirectory:
+		opts = check.Options.FunctionFileMismatch
+	case TemplatesDirectory + "/" + RegistryResourcesDirectory:
+		opts = check.Options.ResourceFileMismatch
+	}
+
+	if opts == nil || len(opts.ResourceNames) == 0 {
+		return nil
+	}
+
+	if fileHasResource(opts.ResourceNames, opts.ProviderName, path) {
+		return nil
+	}
+
+	if NewFileMismatchCheck(opts).IgnoreFileMismatch(path) {
+		return nil
+	}
+
+	return fmt.Errorf("matching %s for template file not found, file is extraneous or incorrectly named", opts.ResourceType)
+}
+
+// frontMatterOptions returns the frontmatter options for the template file or
+// nil if the template does not generate a known documentation file.
+func (check *TemplatesCheck) frontMatterOptions(path string) *FrontMatterOptions {
+	if path == TemplatesDirectory+"/index"+FileExtensionMdTmpl {
+		return check.Options.IndexFrontMatter
+	}
+
+	switch filepath.Dir(path) {
+	case TemplatesDirectory + "/" + RegistryDataSourcesDirectory:
+		return check.Options.DataSourceFrontMatter
+	case TemplatesDirectory + "/" + RegistryFunctionsDirectory:
+		return check.Options.FunctionFrontMatter
+	case TemplatesDirectory + "/" + RegistryGuidesDirectory:
+		return check.Options.GuideFrontMatter
+	case TemplatesDirectory + "/" + RegistryResourcesDirectory:
+		return check.Options.ResourceFrontMatter
+	}
+
+	return nil
+}
+
+// templateData returns the tfplugindocs template data for the template file
+// with a placeholder description.
+func (check *TemplatesCheck) templateData(path string) *TemplateData {
+	var subdirectory string
+
+	if directory := filepath.Dir(path); directory != TemplatesDirectory {
+		subdirectory = filepath.Base(directory)
+	}
+
+	data := newTemplateData(check.Options.FileOptions, check.Options.ProviderName, subdirectory, TrimFileExtension(path))
+
+	if data.Name != "" {
+		data.Description = fmt.Sprintf("Description of %s.", data.Name)
+	}
+
+	return data
+}
+
+// newTemplateData returns the tfplugindocs template data for the documentation
+// subdirectory (e.g. resources or empty for the index) and file name without
+// extension. Example and import files are found relative to the base path and
+// schema information is replaced with a placeholder.
+func newTemplateData(fileOpts *FileOptions, providerName string, subdirectory string, name string) *TemplateData {
+	data := &TemplateData{
+		ProviderName:         "terraform-provider-" + providerName,
+		ProviderShortName:    providerName,
+		RenderedProviderName: "terraform-provider-" + providerName,
+		SchemaMarkdown:       "<!-- schema generated by tfplugindocs -->\n## Schema\n",
+	}
+
+	var exampleDirectory, exampleFile string
+
+	switch subdirectory {
+	case RegistryDataSourcesDirectory:
+		data.Name = fileResourceName(providerName, name)
+		data.Type = "Data Source"
+		exampleDirectory = filepath.Join(ExamplesDirectory, RegistryDataSourcesDirectory, data.Name)
+		exampleFile = "data-source.tf"
+	case RegistryFunctionsDirectory:
+		data.Name = name
+		data.Type = "function"
+		exampleDirectory = filepath.Join(ExamplesDirectory, RegistryFunctionsDirectory, data.Name)
+		exampleFile = "function.tf"
+	case RegistryResourcesDirectory:
+		data.Name = fileResourceName(providerName, name)
+		data.Type = "Resource"
+		exampleDirectory = filepath.Join(ExamplesDirectory, RegistryResourcesDirectory, data.Name)
+		exampleFile = "resource.tf"
+	case "":
+		if name == "index" {
+			exampleDirectory = filepath.Join(ExamplesDirectory, "provider")
+			exampleFile = "provider.tf"
+		}
+	}
+
+	if exampleDirectory == "" {
+		return data
+	}
+
+	data.ExampleFile = filepath.Join(exampleDirectory, exampleFile)
+	data.HasExample = fileOpts.fileExists(data.ExampleFile)
+	data.ImportFile = filepath.Join(exampleDirectory, "import.sh")
+	data.HasImport = fileOpts.fileExists(data.ImportFile)
+	data.ImportIDConfigFile = filepath.Join(exampleDirectory, "import-by-string-id.tf")
+	data.HasImportIDConfig = fileOpts.fileExists(data.ImportIDConfigFile)
+	data.ImportIdentityConfigFile = filepath.Join(exampleDirectory, "import-by-identity.tf")
+	data.HasImportIdentityConfig = fileOpts.fileExists(data.ImportIdentityConfigFile)
+
+	return data
+}
+
+// GetTemplateDirectories returns tfplugindocs template files by directory.
+func GetTemplateDirectories(basepath string) (map[string][]string, error) {
+	return GetTemplateDirectoriesFS(nil, basepath)
+}
+
+// GetTemplateDirectoriesFS returns tfplugindocs template files by directory
+// in the file system. A nil file system is the operating system file system.
+func GetTemplateDirectoriesFS(fsys fs.FS, basepath string) (map[string][]string, error) {
+	fileOpts := &FileOptions{
+		BasePath: basepath,
+		FS:       fsys,
+	}
+
+	files, err := fileOpts.Glob(TemplatesGlobPattern)
+
+	if err != nil {
+		return nil, fmt.Errorf("error globbing Terraform Provider documentation templates: %w", err)
+	}
+
+	directories := make(map[string][]string)
+
+	for _, file := range files {
+		directory := filepath.Dir(file)
+		directories[directory] = append(directories[directory], file)
+	}
+
+	return directories, nil
+}
+
+// RenderTemplate parses and executes tfplugindocs template content with the
+// tfplugindocs template function set. Code files are read relative to the
+// base path.
+func RenderTemplate(basepath string, name string, content []byte, data *TemplateData) ([]byte, error) {
+	return renderTemplate(&FileOptions{BasePath: basepath}, name, content, data)
+}
+
+// renderTemplate parses and executes tfplugindocs template content. Code files
+// are read relative to the base path of the file options.
+func renderTemplate(fileOpts *FileOptions, name string, content []byte, data *TemplateData) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs(fileOpts)).Parse(string(content))
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var result bytes.Buffer
+
+	if err := tmpl.Execute(&result, data); err != nil {
+		return nil, fmt.Errorf("error executing template: %w", err)
+	}
+
+	return result.Bytes(), nil
+}
+
+// templateFuncs returns the tfplugindocs template function set.
+func templateFuncs(fileOpts *FileOptions) template.FuncMap {
+	codeFile := func(format string, file string) (string, error) {
+		return templateCodeFile(fileOpts, format, file)
+	}
+
+	return template.FuncMap{
+		"codefile":      codeFile,
+		"lower":         strings.ToLower,
+		"plainmarkdown": templatePlainMarkdown,
+		"prefixlines":   templatePrefixLines,
+		"split":         strings.Split,
+		"tffile": func(file string) (string, error) {
+			return codeFile("terraform", file)
+		},
+		"title":     templateTitle,
+		"trimspace": strings.TrimSpace,
+		"upper":     strings.ToUpper,
+	}
+}
+
+// templateCodeFile returns the file contents in a Markdown code block.
+func templateCodeFile(fileOpts *FileOptions, format string, file string) (string, error) {
+	content, err := fileOpts.ReadFile(file)
+
+	if err != nil {
+		return "", fmt.Errorf("unable to read content from %q: %w", file, err)
+	}
+
+	text := strings.TrimSpace(string(content))
+
+	if text == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf("```%s\n%s\n```", format, text), nil
+}
+
+// templatePlainMarkdown returns the text of the Markdown without formatting.
+func templatePlainMarkdown(text string) string {
+	source := []byte(text)
+	document, _ := markdown.Parse(source)
+
+	var paragraphs []string
+
+	for node := document.FirstChild(); node != nil; node = node.NextSibling() {
+		switch node := node.(type) {
+		case *ast.Paragraph, *ast.Heading, *ast.TextBlock:
+			paragraphs = append(paragraphs, string(node.Text(source)))
+		default:
+			paragraphs = append(paragraphs, markdown.NodeRawText(node, source))
+		}
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// templatePrefixLines returns the text with the prefix added to each line.
+func templatePrefixLines(prefix string, text string) string {
+	lines := strings.Split(text, "\n")
+
+	for i := range lines {
+		lines[i] = prefix + lines[i]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// templateTitle returns the text with the first letter of each word in upper case.
+func templateTitle(text string) string {
+	runes := []rune(text)
+
+	for i := range runes {
+		if i == 0 || unicode.IsSpace(runes[i-1]) {
+			runes[i] = unicode.ToUpper(runes[i])
+		}
+	}
+
+	return string(runes)
+}
+
+// templateFrontMatterOptions returns a copy of the frontmatter options with
+// the default schema merged.
+func templateFrontMatterOptions(opts *FrontMatterOptions, schema FrontMatterSchema) *FrontMatterOptions {
+	result := &FrontMatterOptions{}
+
+	if opts != nil {
+		*result = *opts
+	}
+
+	result.Schema = schema.Merge(result.Schema)
+
+	return result
+}
+
+// sortedDirectories returns the directory names in sorted order.
+func sortedDirectories(directories map[string][]string) []string {
+	result := make([]string, 0, len(directories))
+
+	for directory := range directories {
+		result = append(result, directory)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
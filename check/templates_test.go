@@ -0,0 +1,220 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTemplatesCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		BasePath     string
+		Options      *TemplatesOptions
+		ExpectErrors int
+	}{
+		{
+			Name:     "disabled",
+			BasePath: "testdata/invalid-templates",
+			Options:  &TemplatesOptions{},
+		},
+		{
+			Name:     "valid templates",
+			BasePath: "testdata/valid-templates",
+			Options: &TemplatesOptions{
+				DataSourceFileMismatch: &FileMismatchOptions{
+					ProviderName:  "test",
+					ResourceType:  ResourceTypeDataSource,
+					ResourceNames: []string{"test_thing"},
+				},
+				Enable:       true,
+				ProviderName: "test",
+				ResourceFileMismatch: &FileMismatchOptions{
+					ProviderName:  "test",
+					ResourceType:  ResourceTypeResource,
+					ResourceNames: []string{"test_other", "test_thing"},
+				},
+				ResourceFrontMatter: &FrontMatterOptions{
+					PageTitleTemplate:                "<resource_name> Resource - terraform-provider-test",
+					ProviderName:                     "test",
+					RequireDescriptionResourceName:   true,
+					RequireDescriptionSingleSentence: true,
+				},
+			},
+		},
+		{
+			Name:     "invalid templates",
+			BasePath: "testdata/invalid-templates",
+			Options: &TemplatesOptions{
+				Enable:       true,
+				ProviderName: "test",
+				ResourceFileMismatch: &FileMismatchOptions{
+					ProviderName:  "test",
+					ResourceType:  ResourceTypeResource,
+					ResourceNames: []string{"test_frontmatter", "test_missing_example", "test_syntax"},
+				},
+			},
+			ExpectErrors: 5,
+		},
+		{
+			Name:     "invalid templates ignore file mismatch",
+			BasePath: "testdata/invalid-templates",
+			Options: &TemplatesOptions{
+				Enable:       true,
+				ProviderName: "test",
+				ResourceFileMismatch: &FileMismatchOptions{
+					IgnoreFileMismatch: []string{"test_unknown"},
+					ProviderName:       "test",
+					ResourceType:       ResourceTypeResource,
+					ResourceNames:      []string{"test_frontmatter", "test_missing_example", "test_syntax"},
+				},
+			},
+			ExpectErrors: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: testCase.BasePath,
+			}
+
+			got := NewTemplatesCheck(testCase.Options).Run()
+
+			if got == nil && testCase.ExpectErrors > 0 {
+				t.Errorf("expected %d errors, got no error", testCase.ExpectErrors)
+			}
+
+			if got != nil && testCase.ExpectErrors == 0 {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got, ok := got.(interface{ WrappedErrors() []error }); ok && len(got.WrappedErrors()) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got errors: %s", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
+
+func TestNewTemplateDataProviderName(t *testing.T) {
+	got := newTemplateData(&FileOptions{BasePath: "testdata/valid-templates"}, "test", RegistryResourcesDirectory, "thing")
+
+	if want := "terraform-provider-test"; got.ProviderName != want {
+		t.Errorf("expected ProviderName %q, got %q", want, got.ProviderName)
+	}
+
+	if want := "test"; got.ProviderShortName != want {
+		t.Errorf("expected ProviderShortName %q, got %q", want, got.ProviderShortName)
+	}
+
+	if want := "terraform-provider-test"; got.RenderedProviderName != want {
+		t.Errorf("expected RenderedProviderName %q, got %q", want, got.RenderedProviderName)
+	}
+}
+
+func TestGetTemplateDirectories(t *testing.T) {
+	got, err := GetTemplateDirectories("testdata/valid-templates")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string][]string{
+		"templates":              {"templates/index.md.tmpl"},
+		"templates/data-sources": {"templates/data-sources/thing.md.tmpl"},
+		"templates/guides":       {"templates/guides/getting-started.md.tmpl"},
+		"templates/resources":    {"templates/resources/thing.md.tmpl"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %#v, got %#v", want, got)
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Content     string
+		Data        *TemplateData
+		Expect      string
+		ExpectError bool
+	}{
+		{
+			Name:    "data",
+			Content: `{{ .Name }} {{ .Type }} - {{ .ProviderName }}`,
+			Data: &TemplateData{
+				Name:         "test_thing",
+				ProviderName: "terraform-provider-test",
+				Type:         "Resource",
+			},
+			Expect: "test_thing Resource - terraform-provider-test",
+		},
+		{
+			Name:    "codefile",
+			Content: `{{ codefile "shell" "examples/resources/test_thing/import.sh" }}`,
+			Data:    &TemplateData{},
+			Expect:  "```shell\nterraform import test_thing.example example\n```",
+		},
+		{
+			Name:    "plainmarkdown and prefixlines",
+			Content: `{{ .Description | plainmarkdown | prefixlines "  " }}`,
+			Data: &TemplateData{
+				Description: "Manages a `test_thing`. See [documentation](https://example.com).",
+			},
+			Expect: "  Manages a test_thing. See documentation.",
+		},
+		{
+			Name:    "tffile",
+			Content: `{{ tffile "examples/provider/provider.tf" }}`,
+			Data:    &TemplateData{},
+			Expect:  "```terraform\nprovider \"test\" {}\n```",
+		},
+		{
+			Name:    "title lower upper split trimspace",
+			Content: `{{ title "test thing" }} {{ lower "TEST" }} {{ upper "test" }} {{ index (split "a,b" ",") 1 }} {{ trimspace " c " }}`,
+			Data:    &TemplateData{},
+			Expect:  "Test Thing test TEST b c",
+		},
+		{
+			Name:        "missing code file",
+			Content:     `{{ tffile "examples/resources/test_missing/resource.tf" }}`,
+			Data:        &TemplateData{},
+			ExpectError: true,
+		},
+		{
+			Name:        "syntax error",
+			Content:     `{{ .Name }`,
+			Data:        &TemplateData{},
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown data field",
+			Content:     `{{ .Nmae }}`,
+			Data:        &TemplateData{},
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown function",
+			Content:     `{{ unknown .Name }}`,
+			Data:        &TemplateData{},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := RenderTemplate("testdata/valid-templates", "test", []byte(testCase.Content), testCase.Data)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if err == nil && string(got) != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, string(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+resource "test_drift" "example" {
+  name = "example"
+  type = "new"
+}
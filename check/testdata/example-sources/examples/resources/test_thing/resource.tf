@@ -0,0 +1,6 @@
+resource "test_thing" "example" {
+  name = "example"
+  tags = {
+    Name = "example"
+  }
+}
@@ -0,0 +1,3 @@
+resource "test_edited" "example" {
+  name = "example"
+}
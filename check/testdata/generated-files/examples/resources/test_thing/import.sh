@@ -0,0 +1 @@
+terraform import test_thing.example example
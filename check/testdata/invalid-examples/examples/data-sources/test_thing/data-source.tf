@@ -0,0 +1,3 @@
+data "test_thing" "example" {
+  name = "example"
+}
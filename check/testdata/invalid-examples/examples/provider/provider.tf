@@ -0,0 +1 @@
+provider "test" {
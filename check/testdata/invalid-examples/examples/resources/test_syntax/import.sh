@@ -0,0 +1 @@
+echo "no import"
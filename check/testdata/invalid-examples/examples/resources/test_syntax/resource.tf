@@ -0,0 +1,3 @@
+resource "test_syntax" "example" {
+  name = 
+}
@@ -0,0 +1 @@
+terraform import test_other.example example
@@ -0,0 +1 @@
+terraform import 'module.test.test_other.example["key"]' example
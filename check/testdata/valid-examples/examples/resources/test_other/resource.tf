@@ -0,0 +1,3 @@
+resource "test_other" "example" {
+  name = "example"
+}
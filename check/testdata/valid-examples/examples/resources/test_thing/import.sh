@@ -0,0 +1,2 @@
+# Import by name
+terraform import test_thing.example example
@@ -0,0 +1,3 @@
+resource "test_thing" "example" {
+  name = "example"
+}
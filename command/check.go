@@ -1,21 +1,29 @@
 package command
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/bflad/tfproviderdocs/check"
+	"github.com/bflad/tfproviderdocs/check/contents"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/mitchellh/cli"
+	"gopkg.in/yaml.v2"
 )
 
 type CheckCommandConfig struct {
@@ -23,7 +31,32 @@ type CheckCommandConfig struct {
 	AllowedGuideSubcategoriesFile    string
 	AllowedResourceSubcategories     string
 	AllowedResourceSubcategoriesFile string
+	ArchiveFile                      string
+	ChangedFilesFile                 string
+	ContentsProfileFile              string
+	DataSourcePageTitleTemplate      string
+	DescriptionMaxLength             int
+	DescriptionSimilarityThreshold   float64
+	EnableDescriptionCheck           bool
+	EnableExampleResourceTypesCheck  bool
+	EnableExampleSourcesCheck        bool
+	EnableExamplesCheck              bool
+	EnableGeneratedFilesCheck        bool
+	EnableGuideContentsCheck         bool
+	EnableCdktfCodeBlocksCheck       bool
+	EnableCdktfParityCheck           bool
+	EnableCdktfStalenessCheck        bool
 	EnableContentsCheck              bool
+	EnableHclFormatCheck             bool
+	EnableHclSyntaxCheck             bool
+	EnableHeadingsCheck              bool
+	EnableLinksCheck                 bool
+	EnableRegistryCdktfCheck         bool
+	EnableTemplatesCheck             bool
+	GuidePageTitleTemplate           string
+	GuideRequiredSectionsFile        string
+	FixHclFormat                     bool
+	FrontMatterSchemaFile            string
 	IgnoreCdktfMissingFiles          bool
 	IgnoreFileMismatchDataSources    string
 	IgnoreFileMismatchFunctions      string
@@ -31,14 +64,22 @@ type CheckCommandConfig struct {
 	IgnoreFileMissingDataSources     string
 	IgnoreFileMissingFunctions       string
 	IgnoreFileMissingResources       string
+	LargestFiles                     int
+	LimitsWarningThreshold           float64
 	LogLevel                         string
+	MaximumNumberOfFiles             int
+	MaximumSizeOfFile                int
 	Path                             string
 	ProviderName                     string
 	ProviderSource                   string
 	ProvidersSchemaJson              string
+	RequireCdktfParity               bool
+	RequireCalloutSyntax             bool
+	RequireDeprecationWarning        bool
 	RequireGuideSubcategory          bool
 	RequireResourceSubcategory       bool
 	RequireSchemaOrdering            bool
+	ResourcePageTitleTemplate        string
 }
 
 // CheckCommand is a Command implementation
@@ -54,7 +95,32 @@ func (*CheckCommand) Help() string {
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-guide-subcategories-file", "Path to newline separated file of allowed guide frontmatter subcategories.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-resource-subcategories", "Comma separated list of allowed data source and resource frontmatter subcategories.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-resource-subcategories-file", "Path to newline separated file of allowed data source and resource frontmatter subcategories.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-archive-file", "Path to .zip, .tar.gz, or .tgz release archive to check instead of the working tree. PATH is then the provider directory within the archive.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-changed-files-file", "Path to newline separated file of changed file paths relative to the provider directory (e.g. git diff --name-only main), reporting changed generated files without template or example changes (requires -enable-generated-files-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-contents-profile-file", "Path to YAML file of expected data source and resource sections, replacing the default profile (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-data-source-page-title-template", "Expected data source frontmatter page_title with <resource_name> and <Subcategory> placeholders (e.g. \"<Subcategory>: <resource_name>\").")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-description-max-length", "Maximum number of characters in data source, guide, and resource frontmatter descriptions.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-description-similarity-threshold", "Minimum similarity (0 to 1) of data source, guide, and resource frontmatter descriptions to the first paragraph after the title heading.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-cdktf-code-blocks-check", "Enable checking code blocks in CDK for Terraform language directories use the language of their directory, with warnings for unconverted terraform code blocks and conversion coverage.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-cdktf-parity-check", "Enable reporting data source and resource files missing from each CDK for Terraform language directory, or missing from the HCL documentation, as warnings with translation coverage.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-cdktf-staleness-check", "Enable checking CDK for Terraform files match the headings, argument and attribute names, and number of example code blocks of their HCL documentation file.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-contents-check", "(Experimental) Enable contents checking.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-description-check", "Enable checking frontmatter descriptions are a single sentence, do not duplicate page_title, and mention the data source or resource name.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-example-resource-types-check", "Enable checking resource and data blocks in terraform and hcl code blocks only reference data source and resource types of the provider (requires -providers-schema-json).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-example-sources-check", "Enable checking the first example code block of data source and resource files matches the tfplugindocs examples/ file (e.g. examples/resources/<name>/resource.tf) after HCL formatting.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-examples-check", "Enable checking tfplugindocs examples/ directories match known data sources and resources (if -providers-schema-json is provided), .tf files have valid HCL syntax, and import.sh scripts import the resource type.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-generated-files-check", "Enable checking files with the tfplugindocs generated header match their templates and examples.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-guide-contents-check", "Enable guide contents checking, such as a single level 1 heading matching page_title, allowed code block languages, and required sections.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-hcl-format-check", "Enable checking terraform code blocks are in canonical format (terraform fmt).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-hcl-syntax-check", "Enable HCL syntax checking of terraform and hcl code blocks.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-headings-check", "Enable checking all files have exactly one level 1 heading, no skipped heading levels, and no duplicate heading text at the same level.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-links-check", "Enable checking internal documentation links and anchors resolve to documentation files and headings.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-registry-cdktf-check", "Enable checking data source and resource files in Terraform Registry CDK for Terraform language directories (e.g. docs/cdktf/typescript).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-templates-check", "Enable checking tfplugindocs templates/**/*.md.tmpl files parse, are named after known data sources, functions, and resources, and render valid frontmatter.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-fix-hcl-format", "Rewrite terraform code blocks that are not in canonical format (terraform fmt) in place.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-frontmatter-schema-file", "Path to YAML file of additional allowed frontmatter keys, each with optional required (bool) and type (bool, int, list, map, or string) fields.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-guide-page-title-template", "Expected guide frontmatter page_title with <resource_name> (file name) and <Subcategory> placeholders.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-guide-required-sections-file", "Path to YAML file of guide file name patterns (e.g. version-*-upgrade.md) to lists of required section headings (requires -enable-guide-contents-check).")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-cdktf-missing-files", "Ignore checks for missing CDK for Terraform documentation files when iteratively introducing them in large providers.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-mismatch-data-sources", "Comma separated list of data sources to ignore mismatched/extra files.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-mismatch-functions", "Comma separated list of functions to ignore mismatched/extra files.")
@@ -62,12 +128,20 @@ func (*CheckCommand) Help() string {
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-data-sources", "Comma separated list of data sources to ignore missing files.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-functions", "Comma separated list of functions to ignore missing files.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-resources", "Comma separated list of resources to ignore missing files.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-largest-files", "Number of largest documentation files to log with their size and percentage of the file size limit.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-limits-warning-threshold", "Percentage (e.g. 90) of the number of files and file size limits at which directories and files are logged as warnings.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-maximum-number-of-files", "Maximum number of documentation files, applied separately to each CDK for Terraform language. Defaults to the Terraform Registry limit (2000).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-maximum-size-of-file", "Maximum size of each documentation file in bytes. Defaults to the Terraform Registry limit (500000).")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws) for Terraform CLI 0.13 and later -providers-schema-json. Automatically sets -provider-name by dropping hostname and namespace prefix.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-providers-schema-json", "Path to terraform providers schema -json file. Enables enhanced validations.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-providers-schema-json", "Path to terraform providers schema -json file. Enables enhanced validations, including example configurations and resource identity import examples (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-cdktf-parity", "Report missing and extraneous CDK for Terraform files as errors instead of warnings (requires -enable-cdktf-parity-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-callout-syntax", "Require note (->), warning (~>), and danger (!>) callout paragraphs to have a space after the prefix and start with a bold label, and no HTML callouts (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-deprecation-warning", "Require a warning callout (~>) in documentation of resources deprecated in -providers-schema-json (requires -enable-contents-check).")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-guide-subcategory", "Require guide frontmatter subcategory.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-resource-subcategory", "Require data source and resource frontmatter subcategory.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-schema-ordering", "Require schema attribute lists to be alphabetically ordered (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-resource-page-title-template", "Expected resource frontmatter page_title with <resource_name> and <Subcategory> placeholders (e.g. \"<Subcategory>: <resource_name>\").")
 	opts.Flush()
 
 	helpText := fmt.Sprintf(`
@@ -95,7 +169,32 @@ func (c *CheckCommand) Run(args []string) int {
 	flags.StringVar(&config.AllowedGuideSubcategoriesFile, "allowed-guide-subcategories-file", "", "")
 	flags.StringVar(&config.AllowedResourceSubcategories, "allowed-resource-subcategories", "", "")
 	flags.StringVar(&config.AllowedResourceSubcategoriesFile, "allowed-resource-subcategories-file", "", "")
+	flags.StringVar(&config.ArchiveFile, "archive-file", "", "")
+	flags.StringVar(&config.ChangedFilesFile, "changed-files-file", "", "")
+	flags.StringVar(&config.ContentsProfileFile, "contents-profile-file", "", "")
+	flags.StringVar(&config.DataSourcePageTitleTemplate, "data-source-page-title-template", "", "")
+	flags.IntVar(&config.DescriptionMaxLength, "description-max-length", 0, "")
+	flags.Float64Var(&config.DescriptionSimilarityThreshold, "description-similarity-threshold", 0, "")
+	flags.BoolVar(&config.EnableCdktfCodeBlocksCheck, "enable-cdktf-code-blocks-check", false, "")
+	flags.BoolVar(&config.EnableCdktfParityCheck, "enable-cdktf-parity-check", false, "")
+	flags.BoolVar(&config.EnableCdktfStalenessCheck, "enable-cdktf-staleness-check", false, "")
 	flags.BoolVar(&config.EnableContentsCheck, "enable-contents-check", false, "")
+	flags.BoolVar(&config.EnableDescriptionCheck, "enable-description-check", false, "")
+	flags.BoolVar(&config.EnableExampleResourceTypesCheck, "enable-example-resource-types-check", false, "")
+	flags.BoolVar(&config.EnableExampleSourcesCheck, "enable-example-sources-check", false, "")
+	flags.BoolVar(&config.EnableExamplesCheck, "enable-examples-check", false, "")
+	flags.BoolVar(&config.EnableGeneratedFilesCheck, "enable-generated-files-check", false, "")
+	flags.BoolVar(&config.EnableGuideContentsCheck, "enable-guide-contents-check", false, "")
+	flags.BoolVar(&config.EnableHclFormatCheck, "enable-hcl-format-check", false, "")
+	flags.BoolVar(&config.EnableHclSyntaxCheck, "enable-hcl-syntax-check", false, "")
+	flags.BoolVar(&config.EnableHeadingsCheck, "enable-headings-check", false, "")
+	flags.BoolVar(&config.EnableLinksCheck, "enable-links-check", false, "")
+	flags.BoolVar(&config.EnableRegistryCdktfCheck, "enable-registry-cdktf-check", false, "")
+	flags.BoolVar(&config.EnableTemplatesCheck, "enable-templates-check", false, "")
+	flags.BoolVar(&config.FixHclFormat, "fix-hcl-format", false, "")
+	flags.StringVar(&config.FrontMatterSchemaFile, "frontmatter-schema-file", "", "")
+	flags.StringVar(&config.GuidePageTitleTemplate, "guide-page-title-template", "", "")
+	flags.StringVar(&config.GuideRequiredSectionsFile, "guide-required-sections-file", "", "")
 	flags.BoolVar(&config.IgnoreCdktfMissingFiles, "ignore-cdktf-missing-files", false, "")
 	flags.StringVar(&config.IgnoreFileMismatchDataSources, "ignore-file-mismatch-data-sources", "", "")
 	flags.StringVar(&config.IgnoreFileMismatchFunctions, "ignore-file-mismatch-functions", "", "")
@@ -103,12 +202,20 @@ func (c *CheckCommand) Run(args []string) int {
 	flags.StringVar(&config.IgnoreFileMissingDataSources, "ignore-file-missing-data-sources", "", "")
 	flags.StringVar(&config.IgnoreFileMissingFunctions, "ignore-file-missing-functions", "", "")
 	flags.StringVar(&config.IgnoreFileMissingResources, "ignore-file-missing-resources", "", "")
+	flags.IntVar(&config.LargestFiles, "largest-files", 0, "")
+	flags.Float64Var(&config.LimitsWarningThreshold, "limits-warning-threshold", 0, "")
+	flags.IntVar(&config.MaximumNumberOfFiles, "maximum-number-of-files", 0, "")
+	flags.IntVar(&config.MaximumSizeOfFile, "maximum-size-of-file", 0, "")
 	flags.StringVar(&config.ProviderName, "provider-name", "", "")
 	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
 	flags.StringVar(&config.ProvidersSchemaJson, "providers-schema-json", "", "")
+	flags.BoolVar(&config.RequireCdktfParity, "require-cdktf-parity", false, "")
+	flags.BoolVar(&config.RequireCalloutSyntax, "require-callout-syntax", false, "")
+	flags.BoolVar(&config.RequireDeprecationWarning, "require-deprecation-warning", false, "")
 	flags.BoolVar(&config.RequireGuideSubcategory, "require-guide-subcategory", false, "")
 	flags.BoolVar(&config.RequireResourceSubcategory, "require-resource-subcategory", false, "")
 	flags.BoolVar(&config.RequireSchemaOrdering, "require-schema-ordering", false, "")
+	flags.StringVar(&config.ResourcePageTitleTemplate, "resource-page-title-template", "", "")
 
 	if err := flags.Parse(args); err != nil {
 		flags.Usage()
@@ -129,7 +236,7 @@ func (c *CheckCommand) Run(args []string) int {
 	}
 
 	if config.ProviderName == "" {
-		if config.Path == "" {
+		if config.Path == "" && config.ArchiveFile == "" {
 			config.ProviderName = providerNameFromCurrentDirectory()
 		} else {
 			config.ProviderName = providerNameFromPath(config.Path)
@@ -142,7 +249,34 @@ func (c *CheckCommand) Run(args []string) int {
 		log.Printf("[DEBUG] Found provider name: %s", config.ProviderName)
 	}
 
-	directories, err := check.GetDirectories(config.Path)
+	if config.MaximumNumberOfFiles < 0 {
+		c.Ui.Error(fmt.Sprintf("The -maximum-number-of-files option must not be negative, got: %d", config.MaximumNumberOfFiles))
+		return 1
+	}
+
+	if config.MaximumSizeOfFile < 0 {
+		c.Ui.Error(fmt.Sprintf("The -maximum-size-of-file option must not be negative, got: %d", config.MaximumSizeOfFile))
+		return 1
+	}
+
+	var fsys fs.FS
+
+	if v := config.ArchiveFile; v != "" {
+		if config.FixHclFormat {
+			c.Ui.Error("The -fix-hcl-format option cannot be used with -archive-file")
+			return 1
+		}
+
+		var err error
+		fsys, err = archiveFileFS(v)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error loading archive file: %s", err))
+			return 1
+		}
+	}
+
+	directories, err := check.GetDirectoriesFS(fsys, config.Path)
 
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
@@ -189,6 +323,64 @@ func (c *CheckCommand) Run(args []string) int {
 		}
 	}
 
+	var changedFiles []string
+	if v := config.ChangedFilesFile; v != "" {
+		var err error
+		changedFiles, err = changedFilesFile(v)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting changed files: %s", err))
+			return 1
+		}
+	}
+
+	var contentsProfile *contents.Profile
+	if v := config.ContentsProfileFile; v != "" {
+		var err error
+		contentsProfile, err = contentsProfileFile(v)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting contents profile: %s", err))
+			return 1
+		}
+	}
+
+	var frontMatterSchema check.FrontMatterSchema
+	if v := config.FrontMatterSchemaFile; v != "" {
+		var err error
+		frontMatterSchema, err = frontMatterSchemaFile(v)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting frontmatter schema: %s", err))
+			return 1
+		}
+	}
+
+	var guideRequiredSections map[string][]string
+	if v := config.GuideRequiredSectionsFile; v != "" {
+		var err error
+		guideRequiredSections, err = guideRequiredSectionsFile(v)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting guide required sections: %s", err))
+			return 1
+		}
+	}
+
+	guideFrontMatterSchema := frontMatterSchema
+	if config.RequireGuideSubcategory {
+		guideFrontMatterSchema = frontMatterSchema.Merge(check.FrontMatterSchema{
+			"subcategory": {Required: true},
+		})
+	}
+
+	resourceFrontMatterSchema := frontMatterSchema
+	if config.RequireResourceSubcategory {
+		resourceFrontMatterSchema = frontMatterSchema.Merge(check.FrontMatterSchema{
+			"subcategory": {Required: true},
+		})
+	}
+
 	var ignoreFileMismatchDataSources []string
 	if v := config.IgnoreFileMismatchDataSources; v != "" {
 		ignoreFileMismatchDataSources = strings.Split(v, ",")
@@ -220,6 +412,8 @@ func (c *CheckCommand) Run(args []string) int {
 	}
 
 	var dataSourceNames, resourceNames, functionNames []string
+	var providerSchema *tfjson.ProviderSchema
+	var resourceIdentitySchemas map[string]*tfjson.IdentitySchema
 	if config.ProvidersSchemaJson != "" {
 		ps, err := providerSchemas(config.ProvidersSchemaJson)
 
@@ -239,10 +433,41 @@ Check that the current working directory or provided path is prefixed with terra
 		dataSourceNames = providerSchemasDataSources(ps, config.ProviderName, config.ProviderSource)
 		functionNames = providerSchemasFunctions(ps, config.ProviderName, config.ProviderSource)
 		resourceNames = providerSchemasResources(ps, config.ProviderName, config.ProviderSource)
+		providerSchema = providerSchemasProvider(ps, config.ProviderName, config.ProviderSource)
+		resourceIdentitySchemas = providerSchemasResourceIdentities(ps, config.ProviderName, config.ProviderSource)
 	}
 
 	fileOpts := &check.FileOptions{
-		BasePath: config.Path,
+		BasePath:          config.Path,
+		FS:                fsys,
+		MaximumSizeOfFile: config.MaximumSizeOfFile,
+	}
+	exampleResourceTypesOpts := &check.ExampleResourceTypesOptions{
+		DataSourceNames: dataSourceNames,
+		Enable:          config.EnableExampleResourceTypesCheck && config.ProvidersSchemaJson != "",
+		ProviderName:    config.ProviderName,
+		ResourceNames:   resourceNames,
+	}
+	guideContentsOpts := &check.GuideContentsOptions{
+		Enable: config.EnableGuideContentsCheck,
+		ExampleResourceTypes: &check.ExampleResourceTypesOptions{
+			DataSourceNames: dataSourceNames,
+			Enable:          config.ProvidersSchemaJson != "",
+			ProviderName:    config.ProviderName,
+			ResourceNames:   resourceNames,
+		},
+		RequiredSections: guideRequiredSections,
+	}
+	headingsOpts := &check.HeadingsOptions{
+		Enable: config.EnableHeadingsCheck,
+	}
+	hclFormatOpts := &check.HclFormatOptions{
+		Enable:      config.EnableHclFormatCheck,
+		FileOptions: fileOpts,
+		Fix:         config.FixHclFormat,
+	}
+	hclSyntaxOpts := &check.HclSyntaxOptions{
+		Enable: config.EnableHclSyntaxCheck,
 	}
 	checkOpts := &check.CheckOptions{
 		DataSourceFileMismatch: &check.FileMismatchOptions{
@@ -258,33 +483,98 @@ Check that the current working directory or provided path is prefixed with terra
 			ResourceType:       check.ResourceTypeFunction,
 			ResourceNames:      functionNames,
 		},
+		GeneratedFiles: &check.GeneratedFilesOptions{
+			ChangedFiles: changedFiles,
+			Enable:       config.EnableGeneratedFilesCheck,
+			FileOptions:  fileOpts,
+			ProviderName: config.ProviderName,
+		},
 		LegacyDataSourceFile: &check.LegacyDataSourceFileOptions{
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedResourceSubcategories,
-				RequireSubcategory:   config.RequireResourceSubcategory,
+				AllowedSubcategories:             allowedResourceSubcategories,
+				DescriptionMaxLength:             config.DescriptionMaxLength,
+				DescriptionSimilarityThreshold:   config.DescriptionSimilarityThreshold,
+				NoDescriptionPageTitle:           config.EnableDescriptionCheck,
+				PageTitleTemplate:                config.DataSourcePageTitleTemplate,
+				ProviderName:                     config.ProviderName,
+				RequireDescriptionResourceName:   config.EnableDescriptionCheck,
+				RequireDescriptionSingleSentence: config.EnableDescriptionCheck,
+				Schema:                           resourceFrontMatterSchema,
 			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
+		},
+		LegacyFunctionFile: &check.LegacyFunctionFileOptions{
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				Schema: frontMatterSchema,
+			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
 		},
 		LegacyGuideFile: &check.LegacyGuideFileOptions{
+			Contents:    guideContentsOpts,
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedGuideSubcategories,
-				RequireSubcategory:   config.RequireGuideSubcategory,
+				AllowedSubcategories:             allowedGuideSubcategories,
+				DescriptionMaxLength:             config.DescriptionMaxLength,
+				DescriptionSimilarityThreshold:   config.DescriptionSimilarityThreshold,
+				NoDescriptionPageTitle:           config.EnableDescriptionCheck,
+				PageTitleTemplate:                config.GuidePageTitleTemplate,
+				RequireDescriptionSingleSentence: config.EnableDescriptionCheck,
+				Schema:                           guideFrontMatterSchema,
 			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
 		},
 		LegacyIndexFile: &check.LegacyIndexFileOptions{
 			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				Schema: frontMatterSchema,
+			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
 		},
 		LegacyResourceFile: &check.LegacyResourceFileOptions{
 			Contents: &check.ContentsOptions{
-				Enable:                config.EnableContentsCheck,
-				RequireSchemaOrdering: config.RequireSchemaOrdering,
+				Enable:                    config.EnableContentsCheck,
+				Profile:                   contentsProfile,
+				ProviderSchema:            providerSchema,
+				RequireCalloutSyntax:      config.RequireCalloutSyntax,
+				RequireDeprecationWarning: config.RequireDeprecationWarning,
+				RequireSchemaOrdering:     config.RequireSchemaOrdering,
+				ResourceIdentitySchemas:   resourceIdentitySchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedResourceSubcategories,
-				RequireSubcategory:   config.RequireResourceSubcategory,
+				AllowedSubcategories:             allowedResourceSubcategories,
+				DescriptionMaxLength:             config.DescriptionMaxLength,
+				DescriptionSimilarityThreshold:   config.DescriptionSimilarityThreshold,
+				NoDescriptionPageTitle:           config.EnableDescriptionCheck,
+				PageTitleTemplate:                config.ResourcePageTitleTemplate,
+				ProviderName:                     config.ProviderName,
+				RequireDescriptionResourceName:   config.EnableDescriptionCheck,
+				RequireDescriptionSingleSentence: config.EnableDescriptionCheck,
+				Schema:                           resourceFrontMatterSchema,
 			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
+			ProviderName:         config.ProviderName,
+		},
+		Links: &check.LinksOptions{
+			FileOptions:  fileOpts,
+			Enable:       config.EnableLinksCheck,
 			ProviderName: config.ProviderName,
 		},
 		ProviderName:   config.ProviderName,
@@ -292,31 +582,85 @@ Check that the current working directory or provided path is prefixed with terra
 		RegistryDataSourceFile: &check.RegistryDataSourceFileOptions{
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedResourceSubcategories,
-				RequireSubcategory:   config.RequireResourceSubcategory,
+				AllowedSubcategories:             allowedResourceSubcategories,
+				DescriptionMaxLength:             config.DescriptionMaxLength,
+				DescriptionSimilarityThreshold:   config.DescriptionSimilarityThreshold,
+				NoDescriptionPageTitle:           config.EnableDescriptionCheck,
+				PageTitleTemplate:                config.DataSourcePageTitleTemplate,
+				ProviderName:                     config.ProviderName,
+				RequireDescriptionResourceName:   config.EnableDescriptionCheck,
+				RequireDescriptionSingleSentence: config.EnableDescriptionCheck,
+				Schema:                           resourceFrontMatterSchema,
 			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
+		},
+		RegistryFunctionFile: &check.RegistryFunctionFileOptions{
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				Schema: frontMatterSchema,
+			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
 		},
 		RegistryGuideFile: &check.RegistryGuideFileOptions{
+			Contents:    guideContentsOpts,
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedGuideSubcategories,
-				RequireSubcategory:   config.RequireGuideSubcategory,
+				AllowedSubcategories:             allowedGuideSubcategories,
+				DescriptionMaxLength:             config.DescriptionMaxLength,
+				DescriptionSimilarityThreshold:   config.DescriptionSimilarityThreshold,
+				NoDescriptionPageTitle:           config.EnableDescriptionCheck,
+				PageTitleTemplate:                config.GuidePageTitleTemplate,
+				RequireDescriptionSingleSentence: config.EnableDescriptionCheck,
+				Schema:                           guideFrontMatterSchema,
 			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
 		},
 		RegistryIndexFile: &check.RegistryIndexFileOptions{
 			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				Schema: frontMatterSchema,
+			},
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
 		},
 		RegistryResourceFile: &check.RegistryResourceFileOptions{
 			Contents: &check.ContentsOptions{
-				Enable:                config.EnableContentsCheck,
-				RequireSchemaOrdering: config.RequireSchemaOrdering,
+				Enable:                    config.EnableContentsCheck,
+				Profile:                   contentsProfile,
+				ProviderSchema:            providerSchema,
+				RequireCalloutSyntax:      config.RequireCalloutSyntax,
+				RequireDeprecationWarning: config.RequireDeprecationWarning,
+				RequireSchemaOrdering:     config.RequireSchemaOrdering,
+				ResourceIdentitySchemas:   resourceIdentitySchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedResourceSubcategories,
-				RequireSubcategory:   config.RequireResourceSubcategory,
+				AllowedSubcategories:             allowedResourceSubcategories,
+				DescriptionMaxLength:             config.DescriptionMaxLength,
+				DescriptionSimilarityThreshold:   config.DescriptionSimilarityThreshold,
+				NoDescriptionPageTitle:           config.EnableDescriptionCheck,
+				PageTitleTemplate:                config.ResourcePageTitleTemplate,
+				ProviderName:                     config.ProviderName,
+				RequireDescriptionResourceName:   config.EnableDescriptionCheck,
+				RequireDescriptionSingleSentence: config.EnableDescriptionCheck,
+				Schema:                           resourceFrontMatterSchema,
 			},
-			ProviderName: config.ProviderName,
+			ExampleResourceTypes: exampleResourceTypesOpts,
+			HclFormat:            hclFormatOpts,
+			Headings:             headingsOpts,
+			HclSyntax:            hclSyntaxOpts,
+			ProviderName:         config.ProviderName,
 		},
 		ResourceFileMismatch: &check.FileMismatchOptions{
 			IgnoreFileMismatch: ignoreFileMismatchResources,
@@ -325,9 +669,59 @@ Check that the current working directory or provided path is prefixed with terra
 			ResourceType:       check.ResourceTypeResource,
 			ResourceNames:      resourceNames,
 		},
+		EnableRegistryCdktf:     config.EnableRegistryCdktfCheck,
 		IgnoreCdktfMissingFiles: config.IgnoreCdktfMissingFiles,
 	}
 
+	checkOpts.Limits = &check.LimitsOptions{
+		FileOptions:          fileOpts,
+		LargestFiles:         config.LargestFiles,
+		MaximumNumberOfFiles: config.MaximumNumberOfFiles,
+		WarningThreshold:     config.LimitsWarningThreshold,
+	}
+
+	checkOpts.CdktfCodeBlocks = &check.CdktfCodeBlocksOptions{
+		Enable:      config.EnableCdktfCodeBlocksCheck,
+		FileOptions: fileOpts,
+	}
+
+	checkOpts.CdktfParity = &check.CdktfParityOptions{
+		Enable:  config.EnableCdktfParityCheck,
+		Require: config.RequireCdktfParity,
+	}
+
+	checkOpts.CdktfStaleness = &check.CdktfStalenessOptions{
+		Enable:      config.EnableCdktfStalenessCheck,
+		FileOptions: fileOpts,
+	}
+
+	checkOpts.ExampleSources = &check.ExampleSourcesOptions{
+		Enable:       config.EnableExampleSourcesCheck,
+		FileOptions:  fileOpts,
+		ProviderName: config.ProviderName,
+	}
+
+	checkOpts.Examples = &check.ExamplesOptions{
+		DataSourceFileMismatch: checkOpts.DataSourceFileMismatch,
+		Enable:                 config.EnableExamplesCheck,
+		FileOptions:            fileOpts,
+		ResourceFileMismatch:   checkOpts.ResourceFileMismatch,
+	}
+
+	checkOpts.Templates = &check.TemplatesOptions{
+		DataSourceFileMismatch: checkOpts.DataSourceFileMismatch,
+		DataSourceFrontMatter:  checkOpts.RegistryDataSourceFile.FrontMatter,
+		Enable:                 config.EnableTemplatesCheck,
+		FileOptions:            fileOpts,
+		FunctionFileMismatch:   checkOpts.FunctionFileMismatch,
+		FunctionFrontMatter:    checkOpts.RegistryFunctionFile.FrontMatter,
+		GuideFrontMatter:       checkOpts.RegistryGuideFile.FrontMatter,
+		IndexFrontMatter:       checkOpts.RegistryIndexFile.FrontMatter,
+		ProviderName:           config.ProviderName,
+		ResourceFileMismatch:   checkOpts.ResourceFileMismatch,
+		ResourceFrontMatter:    checkOpts.RegistryResourceFile.FrontMatter,
+	}
+
 	if err := check.NewCheck(checkOpts).Run(directories); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation: %s", err))
 		return 1
@@ -364,6 +758,109 @@ func allowedSubcategoriesFile(path string) ([]string, error) {
 	return allowedSubcategories, nil
 }
 
+// archiveFileFS reads a .zip, .tar.gz, or .tgz archive file into an in-memory
+// file system.
+func archiveFileFS(path string) (fs.FS, error) {
+	log.Printf("[DEBUG] Loading archive file: %s", path)
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive file (%s): %w", path, err)
+	}
+
+	switch {
+	case strings.HasSuffix(path, ".zip"):
+		fsys, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+
+		if err != nil {
+			return nil, fmt.Errorf("error opening zip archive file (%s): %w", path, err)
+		}
+
+		return fsys, nil
+	case strings.HasSuffix(path, ".tar.gz"), strings.HasSuffix(path, ".tgz"):
+		fsys, err := tarGzipFS(bytes.NewReader(content))
+
+		if err != nil {
+			return nil, fmt.Errorf("error opening tar.gz archive file (%s): %w", path, err)
+		}
+
+		return fsys, nil
+	default:
+		return nil, fmt.Errorf("unsupported archive file (%s), expected .zip, .tar.gz, or .tgz extension", path)
+	}
+}
+
+// tarGzipFS returns an in-memory file system of the regular files and
+// directories in the gzip compressed tar archive. The entries are rewritten
+// into an in-memory zip archive, which already implements fs.FS.
+func tarGzipFS(r io.Reader) (fs.FS, error) {
+	gzipReader, err := gzip.NewReader(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer gzipReader.Close()
+
+	var buf bytes.Buffer
+	tarReader := tar.NewReader(gzipReader)
+	zipWriter := zip.NewWriter(&buf)
+
+	for {
+		header, err := tarReader.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Clean(strings.TrimPrefix(header.Name, "./"))
+
+		if name == "." || !fs.ValidPath(name) {
+			continue
+		}
+
+		if header.Typeflag != tar.TypeDir && header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		zipHeader, err := zip.FileInfoHeader(header.FileInfo())
+
+		if err != nil {
+			return nil, fmt.Errorf("error converting %s: %w", header.Name, err)
+		}
+
+		zipHeader.Name = name
+		zipHeader.Method = zip.Store
+
+		if header.Typeflag == tar.TypeDir {
+			zipHeader.Name += "/"
+		}
+
+		w, err := zipWriter.CreateHeader(zipHeader)
+
+		if err != nil {
+			return nil, fmt.Errorf("error converting %s: %w", header.Name, err)
+		}
+
+		if header.Typeflag == tar.TypeReg {
+			if _, err := io.Copy(w, tarReader); err != nil {
+				return nil, fmt.Errorf("error reading %s: %w", header.Name, err)
+			}
+		}
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+}
+
 func providerNameFromCurrentDirectory() string {
 	path, _ := os.Getwd()
 
@@ -384,6 +881,113 @@ func providerNameFromPath(path string) string {
 	return strings.TrimPrefix(base, "terraform-provider-")
 }
 
+// changedFilesFile reads a newline separated file of changed file paths,
+// ignoring empty lines.
+func changedFilesFile(path string) ([]string, error) {
+	log.Printf("[DEBUG] Loading changed files file: %s", path)
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading changed files file (%s): %w", path, err)
+	}
+
+	var changedFiles []string
+
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" {
+			continue
+		}
+
+		changedFiles = append(changedFiles, filepath.ToSlash(line))
+	}
+
+	return changedFiles, nil
+}
+
+// contentsProfileFile reads, parses, and validates a YAML contents profile file.
+func contentsProfileFile(path string) (*contents.Profile, error) {
+	log.Printf("[DEBUG] Loading contents profile file: %s", path)
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading contents profile file (%s): %w", path, err)
+	}
+
+	var profile contents.Profile
+
+	if err := yaml.UnmarshalStrict(content, &profile); err != nil {
+		return nil, fmt.Errorf("error parsing contents profile file (%s): %w", path, err)
+	}
+
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating contents profile file (%s): %w", path, err)
+	}
+
+	return &profile, nil
+}
+
+// frontMatterSchemaFile reads and parses a YAML frontmatter schema file.
+func frontMatterSchemaFile(path string) (check.FrontMatterSchema, error) {
+	log.Printf("[DEBUG] Loading frontmatter schema file: %s", path)
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading frontmatter schema file (%s): %w", path, err)
+	}
+
+	var schema check.FrontMatterSchema
+
+	if err := yaml.UnmarshalStrict(content, &schema); err != nil {
+		return nil, fmt.Errorf("error parsing frontmatter schema file (%s): %w", path, err)
+	}
+
+	for key, keySchema := range schema {
+		if keySchema == nil {
+			schema[key] = &check.FrontMatterKeySchema{}
+			continue
+		}
+
+		switch keySchema.Type {
+		case "", check.FrontMatterKeyTypeBool, check.FrontMatterKeyTypeInt, check.FrontMatterKeyTypeList, check.FrontMatterKeyTypeMap, check.FrontMatterKeyTypeString:
+		default:
+			return nil, fmt.Errorf("error parsing frontmatter schema file (%s): key (%s) has unknown type: %s", path, key, keySchema.Type)
+		}
+	}
+
+	return schema, nil
+}
+
+// guideRequiredSectionsFile reads and parses a YAML file of guide file name
+// patterns to required section headings.
+func guideRequiredSectionsFile(path string) (map[string][]string, error) {
+	log.Printf("[DEBUG] Loading guide required sections file: %s", path)
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading guide required sections file (%s): %w", path, err)
+	}
+
+	var requiredSections map[string][]string
+
+	if err := yaml.UnmarshalStrict(content, &requiredSections); err != nil {
+		return nil, fmt.Errorf("error parsing guide required sections file (%s): %w", path, err)
+	}
+
+	for pattern := range requiredSections {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("error parsing guide required sections file (%s): invalid pattern (%s): %w", path, pattern, err)
+		}
+	}
+
+	return requiredSections, nil
+}
+
 // providerSchemas reads, parses, and validates a provided terraform provider schema -json path.
 func providerSchemas(path string) (*tfjson.ProviderSchemas, error) {
 	log.Printf("[DEBUG] Loading providers schema JSON file: %s", path)
@@ -467,6 +1071,26 @@ func providerSchemasFunctions(ps *tfjson.ProviderSchemas, providerName string, p
 	return functions
 }
 
+// providerSchemasProvider returns the provider schema from a terraform providers schema -json.
+func providerSchemasProvider(ps *tfjson.ProviderSchemas, providerName string, providerSource string) *tfjson.ProviderSchema {
+	if ps == nil || ps.Schemas == nil {
+		return nil
+	}
+
+	provider, ok := ps.Schemas[providerSource]
+
+	if !ok {
+		provider, ok = ps.Schemas[providerName]
+	}
+
+	if !ok {
+		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
+		return nil
+	}
+
+	return provider
+}
+
 // providerSchemasResources returns all resource names from a terraform providers schema -json provider.
 func providerSchemasResources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) []string {
 	if ps == nil || ps.Schemas == nil {
@@ -496,3 +1120,29 @@ func providerSchemasResources(ps *tfjson.ProviderSchemas, providerName string, p
 
 	return resources
 }
+
+// providerSchemasResourceIdentities returns all resource identity schemas from a terraform providers schema -json provider.
+func providerSchemasResourceIdentities(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.IdentitySchema {
+	if ps == nil || ps.Schemas == nil {
+		return nil
+	}
+
+	provider, ok := ps.Schemas[providerSource]
+
+	if !ok {
+		provider, ok = ps.Schemas[providerName]
+	}
+
+	if !ok {
+		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
+		return nil
+	}
+
+	if len(provider.ResourceIdentitySchemas) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Found provider schema resource identities: %d", len(provider.ResourceIdentitySchemas))
+
+	return provider.ResourceIdentitySchemas
+}
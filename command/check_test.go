@@ -4,7 +4,10 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/bflad/tfproviderdocs/check/contents"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/mitchellh/cli"
 )
 
 func TestAllowedSubcategoriesFile(t *testing.T) {
@@ -51,6 +54,360 @@ func TestAllowedSubcategoriesFile(t *testing.T) {
 	}
 }
 
+func TestArchiveFileFS(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Expect      map[string][]string
+		ExpectError bool
+	}{
+		{
+			Name: "tar.gz",
+			Path: "testdata/archive.tar.gz",
+			Expect: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md"},
+			},
+		},
+		{
+			Name: "zip",
+			Path: "testdata/archive.zip",
+			Expect: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md"},
+			},
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.zip",
+			ExpectError: true,
+		},
+		{
+			Name:        "unsupported extension",
+			Path:        "testdata/archive.txt",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			fsys, err := archiveFileFS(testCase.Path)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			got, err := check.GetDirectoriesFS(fsys, "terraform-provider-test")
+
+			if err != nil {
+				t.Fatalf("unexpected error getting directories: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestCheckCommandArchiveFile(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Args       []string
+		ExpectCode int
+	}{
+		{
+			Name:       "tar.gz",
+			Args:       []string{"-archive-file", "testdata/archive.tar.gz", "terraform-provider-test"},
+			ExpectCode: 0,
+		},
+		{
+			Name:       "zip",
+			Args:       []string{"-archive-file", "testdata/archive.zip", "terraform-provider-test"},
+			ExpectCode: 0,
+		},
+		{
+			Name:       "missing path in archive",
+			Args:       []string{"-archive-file", "testdata/archive.zip", "terraform-provider-missing"},
+			ExpectCode: 1,
+		},
+		{
+			Name:       "fix-hcl-format",
+			Args:       []string{"-archive-file", "testdata/archive.zip", "-fix-hcl-format", "terraform-provider-test"},
+			ExpectCode: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			checkCommand := &CheckCommand{Ui: ui}
+
+			if got := checkCommand.Run(testCase.Args); got != testCase.ExpectCode {
+				t.Errorf("expected exit code %d, got %d: %s", testCase.ExpectCode, got, ui.ErrorWriter.String())
+			}
+		})
+	}
+}
+
+func TestCheckCommandLimits(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Args       []string
+		ExpectCode int
+	}{
+		{
+			Name:       "maximum number of files",
+			Args:       []string{"-archive-file", "testdata/archive.zip", "-maximum-number-of-files", "100", "-maximum-size-of-file", "100000", "terraform-provider-test"},
+			ExpectCode: 0,
+		},
+		{
+			Name:       "negative maximum number of files",
+			Args:       []string{"-archive-file", "testdata/archive.zip", "-maximum-number-of-files", "-1", "terraform-provider-test"},
+			ExpectCode: 1,
+		},
+		{
+			Name:       "negative maximum size of file",
+			Args:       []string{"-archive-file", "testdata/archive.zip", "-maximum-size-of-file", "-1", "terraform-provider-test"},
+			ExpectCode: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			checkCommand := &CheckCommand{Ui: ui}
+
+			if got := checkCommand.Run(testCase.Args); got != testCase.ExpectCode {
+				t.Errorf("expected exit code %d, got %d: %s", testCase.ExpectCode, got, ui.ErrorWriter.String())
+			}
+		})
+	}
+}
+
+func TestChangedFilesFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Expect      []string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/changed-files.txt",
+			Expect: []string{
+				"docs/resources/thing.md",
+				"examples/resources/test_thing/resource.tf",
+			},
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.txt",
+			Expect:      nil,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := changedFilesFile(testCase.Path)
+			want := testCase.Expect
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected: %v, got: %v", want, got)
+			}
+		})
+	}
+}
+
+func TestContentsProfileFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Expect      *contents.Profile
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/contents-profile.yml",
+			Expect: &contents.Profile{
+				Ordered: true,
+				Sections: []*contents.ProfileSection{
+					{
+						ContentTypes:    []string{contents.ContentTypeList, contents.ContentTypeParagraph},
+						HeadingPrefixes: []string{"Resource: ", "Data Source: "},
+						Level:           1,
+						Name:            contents.ProfileSectionTitle,
+						Required:        true,
+					},
+					{
+						Detect:   []string{"Example"},
+						Headings: []string{"Example Usage"},
+						Level:    2,
+						Name:     contents.ProfileSectionExample,
+						Required: true,
+					},
+					{
+						Detect:   []string{"Argument"},
+						Headings: []string{"Argument Reference"},
+						Level:    2,
+						Name:     contents.ProfileSectionArguments,
+						Required: true,
+					},
+					{
+						Detect:   []string{"Attribute"},
+						Headings: []string{"Attribute Reference"},
+						Level:    2,
+						Name:     contents.ProfileSectionAttributes,
+						Required: true,
+					},
+				},
+			},
+		},
+		{
+			Name:        "invalid section name",
+			Path:        "testdata/contents-profile-invalid-section.yml",
+			Expect:      nil,
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.yml",
+			Expect:      nil,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := contentsProfileFile(testCase.Path)
+			want := testCase.Expect
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected: %v, got: %v", want, got)
+			}
+		})
+	}
+}
+
+func TestFrontMatterSchemaFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Expect      check.FrontMatterSchema
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/frontmatter-schema.yml",
+			Expect: check.FrontMatterSchema{
+				"nav_title": {
+					Type: check.FrontMatterKeyTypeString,
+				},
+				"weight": {
+					Required: true,
+					Type:     check.FrontMatterKeyTypeInt,
+				},
+			},
+		},
+		{
+			Name:        "invalid type",
+			Path:        "testdata/frontmatter-schema-invalid-type.yml",
+			Expect:      nil,
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.yml",
+			Expect:      nil,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := frontMatterSchemaFile(testCase.Path)
+			want := testCase.Expect
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected: %v, got: %v", want, got)
+			}
+		})
+	}
+}
+
+func TestGuideRequiredSectionsFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Expect      map[string][]string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/guide-required-sections.yml",
+			Expect: map[string][]string{
+				"version-*-upgrade.md": {"Provider Version Configuration"},
+			},
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.yml",
+			Expect:      nil,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := guideRequiredSectionsFile(testCase.Path)
+			want := testCase.Expect
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected: %v, got: %v", want, got)
+			}
+		})
+	}
+}
+
 func TestProviderNameFromPath(t *testing.T) {
 	testCases := []struct {
 		Name   string
@@ -442,3 +799,164 @@ func TestProviderSchemasResources(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderSchemasResourceIdentities(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		ProviderName    string
+		ProviderSource  string
+		ProvidersSchema *tfjson.ProviderSchemas
+		Expect          map[string]*tfjson.IdentitySchema
+	}{
+		{
+			Name:            "no providers schemas",
+			ProviderName:    "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{},
+			Expect:          nil,
+		},
+		{
+			Name:         "provider name not found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+				},
+			},
+			Expect: nil,
+		},
+		{
+			Name:         "provider name found without identities",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"test": {
+						ResourceSchemas: map[string]*tfjson.Schema{
+							"test_resource1": {},
+						},
+					},
+				},
+			},
+			Expect: nil,
+		},
+		{
+			Name:           "provider source found",
+			ProviderSource: "registry.terraform.io/test/test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/test/incorrect": {},
+					"registry.terraform.io/test/test": {
+						ResourceSchemas: map[string]*tfjson.Schema{
+							"test_resource1": {},
+							"test_resource2": {},
+						},
+						ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+							"test_resource1": {
+								Attributes: map[string]*tfjson.IdentityAttribute{
+									"name": {
+										RequiredForImport: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expect: map[string]*tfjson.IdentitySchema{
+				"test_resource1": {
+					Attributes: map[string]*tfjson.IdentityAttribute{
+						"name": {
+							RequiredForImport: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			want := testCase.Expect
+			got := providerSchemasResourceIdentities(testCase.ProvidersSchema, testCase.ProviderName, testCase.ProviderSource)
+
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("mismatch:\n\nwant:\n\n%v\n\ngot:\n\n%v\n\n", want, got)
+			}
+		})
+	}
+}
+
+func TestProviderSchemasProvider(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		ProviderName    string
+		ProviderSource  string
+		ProvidersSchema *tfjson.ProviderSchemas
+		Expect          *tfjson.ProviderSchema
+	}{
+		{
+			Name:            "no providers schemas",
+			ProviderName:    "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{},
+			Expect:          nil,
+		},
+		{
+			Name:         "provider name not found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+				},
+			},
+			Expect: nil,
+		},
+		{
+			Name:         "provider name found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+					"test": {
+						ResourceSchemas: map[string]*tfjson.Schema{
+							"test_resource1": {},
+						},
+					},
+				},
+			},
+			Expect: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+				},
+			},
+		},
+		{
+			Name:           "provider source found",
+			ProviderSource: "registry.terraform.io/test/test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/test/incorrect": {},
+					"registry.terraform.io/test/test": {
+						ResourceSchemas: map[string]*tfjson.Schema{
+							"test_resource1": {},
+						},
+					},
+				},
+			},
+			Expect: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			want := testCase.Expect
+			got := providerSchemasProvider(testCase.ProvidersSchema, testCase.ProviderName, testCase.ProviderSource)
+
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("mismatch:\n\nwant:\n\n%v\n\ngot:\n\n%v\n\n", want, got)
+			}
+		})
+	}
+}
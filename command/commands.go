@@ -14,6 +14,11 @@ func Commands(ui cli.Ui) map[string]cli.CommandFactory {
 				Ui: ui,
 			}, nil
 		},
+		"links": func() (cli.Command, error) {
+			return &LinksCommand{
+				Ui: ui,
+			}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &VersionCommand{
 				Version: version.GetVersion(),
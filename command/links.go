@@ -0,0 +1,173 @@
+package command
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/mitchellh/cli"
+)
+
+type LinksCommandConfig struct {
+	AllowlistFile   string
+	ArchiveFile     string
+	CheckerEndpoint string
+	LogLevel        string
+	Path            string
+}
+
+// LinksCommand is a Command implementation
+type LinksCommand struct {
+	Ui cli.Ui
+}
+
+func (*LinksCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowlist-file", "Path to newline separated file of allowed external link URLs. Entries ending with * allow all URLs with that prefix.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-archive-file", "Path to .zip, .tar.gz, or .tgz release archive to list links from instead of the working tree. PATH is then the provider directory within the archive.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-checker-endpoint", "HTTP endpoint to check each external link URL, passed in the url query parameter. Any 2xx response status code is considered valid.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs links [options] [PATH]
+
+  Lists external links in the given Terraform Provider codebase documentation
+  with the files using each link. External links are only verified if a
+  checker option is provided.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *LinksCommand) Name() string { return "links" }
+
+func (c *LinksCommand) Run(args []string) int {
+	var config LinksCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.StringVar(&config.AllowlistFile, "allowlist-file", "", "")
+	flags.StringVar(&config.ArchiveFile, "archive-file", "", "")
+	flags.StringVar(&config.CheckerEndpoint, "checker-endpoint", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	ConfigureLogging(c.Name(), config.LogLevel)
+
+	if config.AllowlistFile != "" && config.CheckerEndpoint != "" {
+		c.Ui.Error("Only one of -allowlist-file or -checker-endpoint can be provided")
+		return 1
+	}
+
+	var checker check.ExternalLinkChecker
+
+	if config.AllowlistFile != "" {
+		allowlistChecker, err := check.NewAllowlistExternalLinkChecker(config.AllowlistFile)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting external link allowlist: %s", err))
+			return 1
+		}
+
+		checker = allowlistChecker
+	}
+
+	if config.CheckerEndpoint != "" {
+		checker = check.NewHttpExternalLinkChecker(config.CheckerEndpoint)
+	}
+
+	var fsys fs.FS
+
+	if v := config.ArchiveFile; v != "" {
+		var err error
+		fsys, err = archiveFileFS(v)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error loading archive file: %s", err))
+			return 1
+		}
+	}
+
+	directories, err := check.GetDirectoriesFS(fsys, config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return 1
+	}
+
+	if len(directories) == 0 {
+		if config.Path == "" {
+			c.Ui.Error("No Terraform Provider documentation directories found in current path")
+		} else {
+			c.Ui.Error(fmt.Sprintf("No Terraform Provider documentation directories found in path: %s", config.Path))
+		}
+
+		return 1
+	}
+
+	externalLinks, err := check.ExternalLinks(directories, &check.FileOptions{BasePath: config.Path, FS: fsys})
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting external links: %s", err))
+		return 1
+	}
+
+	links := make([]string, 0, len(externalLinks))
+
+	for link := range externalLinks {
+		links = append(links, link)
+	}
+
+	sort.Strings(links)
+
+	var invalidLinks int
+
+	for _, link := range links {
+		c.Ui.Output(link)
+
+		for _, file := range externalLinks[link] {
+			c.Ui.Output(fmt.Sprintf("  %s", file))
+		}
+
+		if checker == nil {
+			continue
+		}
+
+		if err := checker.CheckExternalLink(link); err != nil {
+			invalidLinks++
+			c.Ui.Error(fmt.Sprintf("  Error checking external link (%s): %s", link, err))
+		}
+	}
+
+	if invalidLinks > 0 {
+		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation: %d of %d external links invalid", invalidLinks, len(links)))
+		return 1
+	}
+
+	return 0
+}
+
+func (c *LinksCommand) Synopsis() string {
+	return "Lists and checks external links in Terraform Provider documentation"
+}
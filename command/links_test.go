@@ -0,0 +1,100 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestLinksCommand_implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &LinksCommand{}
+}
+
+func TestLinksCommand_Run(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Args         []string
+		ExpectCode   int
+		ExpectError  string
+		ExpectOutput string
+	}{
+		{
+			Name:       "listing",
+			Args:       []string{"testdata/links"},
+			ExpectCode: 0,
+			ExpectOutput: "https://developer.hashicorp.com/terraform/language\n" +
+				"  docs/index.md\n" +
+				"  docs/resources/thing.md\n" +
+				"https://example.com/api\n" +
+				"  docs/index.md\n",
+		},
+		{
+			Name:       "archive file",
+			Args:       []string{"-archive-file", "testdata/links.zip", "terraform-provider-test"},
+			ExpectCode: 0,
+			ExpectOutput: "https://developer.hashicorp.com/terraform/language\n" +
+				"  docs/index.md\n" +
+				"  docs/resources/thing.md\n" +
+				"https://example.com/api\n" +
+				"  docs/index.md\n",
+		},
+		{
+			Name:        "archive file invalid",
+			Args:        []string{"-archive-file", "testdata/archive.txt", "terraform-provider-test"},
+			ExpectCode:  1,
+			ExpectError: "Error loading archive file",
+		},
+		{
+			Name:        "missing path in archive",
+			Args:        []string{"-archive-file", "testdata/links.zip", "terraform-provider-missing"},
+			ExpectCode:  1,
+			ExpectError: "No Terraform Provider documentation directories found in path: terraform-provider-missing",
+		},
+		{
+			Name:        "allowlist and checker endpoint",
+			Args:        []string{"-allowlist-file", "testdata/links-allowlist.txt", "-checker-endpoint", "http://localhost", "testdata/links"},
+			ExpectCode:  1,
+			ExpectError: "Only one of -allowlist-file or -checker-endpoint can be provided",
+		},
+		{
+			Name:       "allowlist valid",
+			Args:       []string{"-allowlist-file", "testdata/links-allowlist.txt", "testdata/links"},
+			ExpectCode: 0,
+		},
+		{
+			Name:        "allowlist invalid",
+			Args:        []string{"-allowlist-file", "testdata/links-allowlist-partial.txt", "testdata/links"},
+			ExpectCode:  1,
+			ExpectError: "1 of 2 external links invalid",
+		},
+		{
+			Name:        "allowlist file not found",
+			Args:        []string{"-allowlist-file", "testdata/does-not-exist.txt", "testdata/links"},
+			ExpectCode:  1,
+			ExpectError: "Error getting external link allowlist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			linksCommand := &LinksCommand{Ui: ui}
+
+			if got := linksCommand.Run(testCase.Args); got != testCase.ExpectCode {
+				t.Errorf("expected exit code %d, got %d: %s", testCase.ExpectCode, got, ui.ErrorWriter.String())
+			}
+
+			if got := ui.ErrorWriter.String(); !strings.Contains(got, testCase.ExpectError) {
+				t.Errorf("expected error output to contain %q, got: %s", testCase.ExpectError, got)
+			}
+
+			if testCase.ExpectOutput != "" {
+				if got := ui.OutputWriter.String(); got != testCase.ExpectOutput {
+					t.Errorf("expected output:\n\n%s\ngot:\n\n%s", testCase.ExpectOutput, got)
+				}
+			}
+		})
+	}
+}
@@ -27,8 +27,17 @@ func FencedCodeBlockLanguage(fcb *ast.FencedCodeBlock, source []byte) string {
 	return language
 }
 
-// FencedCodeBlockText returns the text
-func FencedCodeBlockText(fcb *ast.FencedCodeBlock, source []byte) string {
+// FencedCodeBlockLineNumber returns the source line number of the first code line or 0
+func FencedCodeBlockLineNumber(fcb *ast.FencedCodeBlock, source []byte) int {
+	if fcb == nil || fcb.Lines().Len() == 0 {
+		return 0
+	}
+
+	return lineNumber(source, fcb.Lines().At(0).Start)
+}
+
+// FencedCodeBlockRawText returns the text without trimming surrounding whitespace
+func FencedCodeBlockRawText(fcb *ast.FencedCodeBlock, source []byte) string {
 	if fcb == nil {
 		return ""
 	}
@@ -41,5 +50,10 @@ func FencedCodeBlockText(fcb *ast.FencedCodeBlock, source []byte) string {
 		builder.WriteString(string(segment.Value(source)))
 	}
 
-	return strings.TrimSpace(builder.String())
+	return builder.String()
+}
+
+// FencedCodeBlockText returns the text
+func FencedCodeBlockText(fcb *ast.FencedCodeBlock, source []byte) string {
+	return strings.TrimSpace(FencedCodeBlockRawText(fcb, source))
 }
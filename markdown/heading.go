@@ -0,0 +1,69 @@
+package markdown
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// HeadingAnchor returns the anchor identifier generated for heading text
+//
+// Letters and numbers are lowercased, spaces are replaced with hyphens, and
+// all other characters except hyphens and underscores are removed.
+func HeadingAnchor(text string) string {
+	var builder strings.Builder
+
+	for _, r := range strings.TrimSpace(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			builder.WriteRune(unicode.ToLower(r))
+		case r == ' ':
+			builder.WriteRune('-')
+		case r == '-' || r == '_':
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// Headings returns all headings of the document in order
+func Headings(document ast.Node) []*ast.Heading {
+	var result []*ast.Heading
+
+	_ = WalkHeadings(document, func(heading *ast.Heading) error {
+		result = append(result, heading)
+
+		return nil
+	}, nil)
+
+	return result
+}
+
+// WalkHeadings walks the document in order, calling headingFn for each
+// heading and, if not nil, walker for all other nodes. Heading children, which
+// only contain inline text, are not walked.
+func WalkHeadings(document ast.Node, headingFn func(*ast.Heading) error, walker ast.Walker) error {
+	return ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+
+		if !ok {
+			if walker == nil {
+				return ast.WalkContinue, nil
+			}
+
+			return walker(node, entering)
+		}
+
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if err := headingFn(heading); err != nil {
+			return ast.WalkStop, err
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+}
@@ -0,0 +1,39 @@
+package markdown
+
+import (
+	"net/url"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// ExternalLinks returns the http and https link destinations of the document in order
+//
+// This includes inline, reference, and automatic links, but not images.
+func ExternalLinks(document ast.Node, source []byte) []string {
+	var result []string
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		var destination string
+
+		switch node := node.(type) {
+		case *ast.AutoLink:
+			destination = string(node.URL(source))
+		case *ast.Link:
+			destination = string(node.Destination)
+		default:
+			return ast.WalkContinue, nil
+		}
+
+		if u, err := url.Parse(destination); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+			result = append(result, destination)
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return result
+}
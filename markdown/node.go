@@ -0,0 +1,69 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// NodeLineNumber returns the source line number of the node or 0
+//
+// Block nodes use their first line. Inline nodes, such as links, use their
+// first text descendant or otherwise their nearest block ancestor.
+func NodeLineNumber(node ast.Node, source []byte) int {
+	if node == nil {
+		return 0
+	}
+
+	if node.Type() == ast.TypeBlock {
+		if node.Lines().Len() == 0 {
+			return 0
+		}
+
+		return lineNumber(source, node.Lines().At(0).Start)
+	}
+
+	start := -1
+
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if text, ok := n.(*ast.Text); ok {
+			start = text.Segment.Start
+
+			return ast.WalkStop, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	if start >= 0 {
+		return lineNumber(source, start)
+	}
+
+	return NodeLineNumber(node.Parent(), source)
+}
+
+func lineNumber(source []byte, offset int) int {
+	return bytes.Count(source[:offset], []byte("\n")) + 1
+}
+
+// NodeRawText returns the unmodified source lines of a block node, including
+// any inline Markdown syntax (e.g. **bold**), or an empty string.
+func NodeRawText(node ast.Node, source []byte) string {
+	if node == nil || node.Type() != ast.TypeBlock {
+		return ""
+	}
+
+	var text bytes.Buffer
+	lines := node.Lines()
+
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		text.Write(segment.Value(source))
+	}
+
+	return text.String()
+}